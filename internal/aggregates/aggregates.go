@@ -3,6 +3,7 @@ package aggregates
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -116,3 +117,248 @@ func FetchAggregateByName(aggregateName, projectID string) *aggregates.Aggregate
 
 	return &allAggregates[0] // Return the first match
 }
+
+// CreateAggregate creates a host aggregate, optionally exposing it as an availability zone.
+func CreateAggregate(name, availabilityZone string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = aggregates.Create(client, aggregates.CreateOpts{
+		Name:             name,
+		AvailabilityZone: availabilityZone,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create aggregate:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAggregate deletes a host aggregate. Nova refuses this while the aggregate still has hosts.
+func DeleteAggregate(aggregateID int) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = aggregates.Delete(client, aggregateID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete aggregate:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetAggregateAvailabilityZone changes the availability zone an aggregate
+// exposes. An empty zone removes it.
+func SetAggregateAvailabilityZone(aggregateID int, availabilityZone string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	// aggregates.UpdateOpts omits an empty availability_zone, so clearing the
+	// zone sends null through a raw request instead.
+	var zone interface{}
+	if availabilityZone != "" {
+		zone = availabilityZone
+	}
+	_, err = client.Put(client.ServiceURL("os-aggregates", strconv.Itoa(aggregateID)), map[string]interface{}{
+		"aggregate": map[string]interface{}{
+			"availability_zone": zone,
+		},
+	}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		fmt.Println("Failed to update aggregate:", err)
+		return err
+	}
+
+	return nil
+}
+
+// AddAggregateHost adds a compute host to an aggregate.
+func AddAggregateHost(aggregateID int, host string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = aggregates.AddHost(client, aggregateID, aggregates.AddHostOpts{Host: host}).Extract()
+	if err != nil {
+		fmt.Println("Failed to add host to aggregate:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveAggregateHost removes a compute host from an aggregate.
+func RemoveAggregateHost(aggregateID int, host string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = aggregates.RemoveHost(client, aggregateID, aggregates.RemoveHostOpts{Host: host}).Extract()
+	if err != nil {
+		fmt.Println("Failed to remove host from aggregate:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetAggregateMetadatum creates or updates a single metadata key of an aggregate.
+func SetAggregateMetadatum(aggregateID int, key, value string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = aggregates.SetMetadata(client, aggregateID, aggregates.SetMetadataOpts{
+		Metadata: map[string]interface{}{key: value},
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to set aggregate metadata:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAggregateMetadatum removes a single metadata key from an aggregate.
+func DeleteAggregateMetadatum(aggregateID int, key string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	// A null value tells nova to drop the key.
+	_, err = aggregates.SetMetadata(client, aggregateID, aggregates.SetMetadataOpts{
+		Metadata: map[string]interface{}{key: nil},
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to delete aggregate metadata:", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package availabilityzones
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	volume_availabilityzones "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+)
+
+// FetchComputeAvailabilityZones retrieves the compute availability zones with
+// their hosts and the state of each service on those hosts (admin).
+func FetchComputeAvailabilityZones() []availabilityzones.AvailabilityZone {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := availabilityzones.ListDetail(client).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list availability zones:", err)
+		return nil
+	}
+
+	zoneList, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract availability zones:", err)
+		return nil
+	}
+
+	return zoneList
+}
+
+// FetchVolumeAvailabilityZones retrieves the block storage availability zones.
+func FetchVolumeAvailabilityZones() []volume_availabilityzones.AvailabilityZone {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := volume_availabilityzones.List(client).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volume availability zones:", err)
+		return nil
+	}
+
+	zoneList, err := volume_availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volume availability zones:", err)
+		return nil
+	}
+
+	return zoneList
+}
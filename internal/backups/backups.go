@@ -0,0 +1,188 @@
+package backups
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+// FetchBackups retrieves the volume backups of the current project.
+func FetchBackups() []backups.Backup {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := backups.ListDetail(client, backups.ListDetailOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list backups:", err)
+		return nil
+	}
+
+	backupList, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract backups:", err)
+		return nil
+	}
+
+	return backupList
+}
+
+// FetchBackupByID retrieves a single backup by its ID.
+func FetchBackupByID(backupID string) *backups.Backup {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	backup, err := backups.Get(client, backupID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get backup details:", err)
+		return nil
+	}
+
+	return backup
+}
+
+// CreateBackup backs up a volume and returns the backup ID. An empty
+// container uses the backup driver's default; force allows backing up an
+// attached volume.
+func CreateBackup(volumeID, name, container string, incremental, force bool) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return "", err
+	}
+
+	backup, err := backups.Create(client, backups.CreateOpts{
+		VolumeID:    volumeID,
+		Name:        name,
+		Container:   container,
+		Incremental: incremental,
+		Force:       force,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create backup:", err)
+		return "", err
+	}
+
+	return backup.ID, nil
+}
+
+// RestoreBackup restores a backup onto an existing volume when volumeID is
+// set, or onto a new volume called name otherwise, and returns the volume ID.
+func RestoreBackup(backupID, volumeID, name string) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return "", err
+	}
+
+	restore, err := backups.RestoreFromBackup(client, backupID, backups.RestoreOpts{
+		VolumeID: volumeID,
+		Name:     name,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to restore backup:", err)
+		return "", err
+	}
+
+	return restore.VolumeID, nil
+}
+
+// DeleteBackup deletes a volume backup.
+func DeleteBackup(backupID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = backups.Delete(client, backupID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete backup:", err)
+		return err
+	}
+
+	return nil
+}
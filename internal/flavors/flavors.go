@@ -3,6 +3,7 @@ package flavors
 import (
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -18,6 +19,10 @@ type Flavor struct {
 	Disk  int // in GB
 }
 
+// flavorNames caches flavor ID to name lookups made by FetchFlavorName.
+var flavorNames = map[string]string{}
+var flavorNamesMu sync.Mutex
+
 // fetchFlavors attempts to retrieve flavors from OpenStack.
 // Falls back to mockFlavors on failure or no data.
 func FetchFlavors() []flavors.Flavor {
@@ -80,3 +85,29 @@ func FetchFlavorByID(flavorId string) *flavors.Flavor {
 
 	return flavorDetails
 }
+
+// FetchFlavorName resolves a flavor ID to its name, caching the result.
+// The ID is returned as-is when the flavor cannot be looked up.
+func FetchFlavorName(flavorID string) string {
+	if flavorID == "" {
+		return ""
+	}
+
+	flavorNamesMu.Lock()
+	name, ok := flavorNames[flavorID]
+	flavorNamesMu.Unlock()
+	if ok {
+		return name
+	}
+
+	name = flavorID
+	if flavor := FetchFlavorByID(flavorID); flavor != nil {
+		name = flavor.Name
+	}
+
+	flavorNamesMu.Lock()
+	flavorNames[flavorID] = name
+	flavorNamesMu.Unlock()
+
+	return name
+}
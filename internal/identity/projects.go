@@ -3,6 +3,7 @@ package projects
 import (
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -20,6 +21,10 @@ type Project struct {
 	Enabled     bool
 }
 
+// projectNames caches project ID to name lookups made by FetchProjectName.
+var projectNames = map[string]string{}
+var projectNamesMu sync.Mutex
+
 // fetchProjects tries to retrieve projects from Keystone.
 // Falls back to mockProjects on error or empty response.
 func FetchProjects() []openstack_projects.Project {
@@ -149,3 +154,29 @@ func FetchDomainIDByName(domainName string) domains.Domain {
 
 	return allDomains[0]
 }
+
+// FetchProjectName resolves a project ID to its name, caching the result.
+// The ID is returned as-is when the project cannot be looked up.
+func FetchProjectName(projectID string) string {
+	if projectID == "" {
+		return ""
+	}
+
+	projectNamesMu.Lock()
+	name, ok := projectNames[projectID]
+	projectNamesMu.Unlock()
+	if ok {
+		return name
+	}
+
+	name = projectID
+	if project := FetchProjectByID(projectID); project != nil {
+		name = project.Name
+	}
+
+	projectNamesMu.Lock()
+	projectNames[projectID] = name
+	projectNamesMu.Unlock()
+
+	return name
+}
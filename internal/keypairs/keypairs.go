@@ -0,0 +1,201 @@
+package keypairs
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"golang.org/x/crypto/ssh"
+)
+
+// FetchKeypairs retrieves the Nova keypairs of the current user.
+func FetchKeypairs() []keypairs.KeyPair {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// The keypair type is returned from 2.2 onwards.
+	client.Microversion = "2.2"
+
+	allPages, err := keypairs.List(client, nil).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list keypairs:", err)
+		return nil
+	}
+
+	keypairList, err := keypairs.ExtractKeyPairs(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract keypairs:", err)
+		return nil
+	}
+
+	return keypairList
+}
+
+// ImportKeypair uploads an OpenSSH public key as a new keypair.
+func ImportKeypair(name, publicKey string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.2"
+
+	_, err = keypairs.Create(client, keypairs.CreateOpts{
+		Name:      name,
+		Type:      "ssh",
+		PublicKey: strings.TrimSpace(publicKey),
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to import keypair:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ImportKeypairFromFile imports the OpenSSH public key stored at path.
+func ImportKeypairFromFile(name, path string) error {
+	publicKey, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Println("Failed to read public key:", err)
+		return err
+	}
+
+	return ImportKeypair(name, string(publicKey))
+}
+
+// GenerateKeypair creates a new ed25519 or rsa key locally, writes the private
+// key to privateKeyPath with 0600 permissions and imports the public key.
+// Nova no longer generates keys server-side from microversion 2.92.
+func GenerateKeypair(name, keyType, privateKeyPath string) error {
+	var privateKey interface{}
+	var publicKey interface{}
+	switch keyType {
+	case "ed25519":
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return err
+		}
+		privateKey, publicKey = priv, pub
+	case "rsa":
+		priv, err := rsa.GenerateKey(rand.Reader, 4096)
+		if err != nil {
+			return err
+		}
+		privateKey, publicKey = priv, &priv.PublicKey
+	default:
+		return fmt.Errorf("unsupported key type %q", keyType)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, name)
+	if err != nil {
+		fmt.Println("Failed to encode private key:", err)
+		return err
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		fmt.Println("Failed to encode public key:", err)
+		return err
+	}
+
+	// O_EXCL so an existing key is never overwritten. The file is created before
+	// the import so an unusable path fails early, and removed again if anything
+	// after that fails so a retry with the same path works.
+	file, err := os.OpenFile(privateKeyPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		fmt.Println("Failed to create private key file:", err)
+		return err
+	}
+
+	if err := ImportKeypair(name, string(ssh.MarshalAuthorizedKey(sshPublicKey))); err != nil {
+		file.Close()
+		os.Remove(privateKeyPath)
+		return err
+	}
+
+	// Close flushes the key to disk, so its error means the key was not saved.
+	err = pem.Encode(file, block)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Println("Failed to write private key:", err)
+		os.Remove(privateKeyPath)
+		DeleteKeypair(name)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteKeypair deletes a keypair by name.
+func DeleteKeypair(name string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = keypairs.Delete(client, name, nil).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete keypair:", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package maintenance
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	openstack_servers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/neilfarmer/internal/servers"
+	"github.com/neilfarmer/internal/services"
+)
+
+// pollInterval is how often a moving server is checked.
+const pollInterval = 5 * time.Second
+
+// moveTimeout is how long a single server may take to leave the host.
+const moveTimeout = time.Hour
+
+// ServerProgress tracks moving a single server off a host.
+type ServerProgress struct {
+	ID     string
+	Name   string
+	Action string
+	Status string
+	Err    error
+}
+
+// Drain disables the compute service of a host and moves every server off it.
+type Drain struct {
+	Host string
+
+	mu       sync.Mutex
+	servers  []*ServerProgress
+	err      error
+	disabled bool
+	finished bool
+}
+
+// StartDrain disables the compute service serviceID with reason, then
+// live-migrates (or evacuates when evacuate is set) every server on host,
+// running at most concurrency moves at a time. Stopped servers are cold
+// migrated and confirmed instead. Progress is read with Progress.
+func StartDrain(host, serviceID, reason string, evacuate, blockMigration bool, concurrency int) *Drain {
+	drain := &Drain{Host: host}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	go func() {
+		defer func() {
+			drain.mu.Lock()
+			drain.finished = true
+			drain.mu.Unlock()
+		}()
+
+		if err := services.DisableService(serviceID, reason); err != nil {
+			drain.setErr(fmt.Errorf("disabling compute service: %w", err))
+			return
+		}
+		drain.mu.Lock()
+		drain.disabled = true
+		drain.mu.Unlock()
+
+		serverList := servers.FetchServers(openstack_servers.ListOpts{AllTenants: true, Host: host})
+		drain.mu.Lock()
+		for _, server := range serverList {
+			drain.servers = append(drain.servers, &ServerProgress{ID: server.ID, Name: server.Name, Status: "pending"})
+		}
+		progressList := drain.servers
+		drain.mu.Unlock()
+
+		semaphore := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for index, server := range serverList {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(progress *ServerProgress, server servers.ServerWithExt) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+				drain.moveServer(progress, server, evacuate, blockMigration)
+			}(progressList[index], server)
+		}
+		wg.Wait()
+	}()
+
+	return drain
+}
+
+// Progress returns a copy of the per-server progress, whether the drain has
+// finished, and any error that stopped it.
+func (d *Drain) Progress() ([]ServerProgress, bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	progressList := make([]ServerProgress, 0, len(d.servers))
+	for _, progress := range d.servers {
+		progressList = append(progressList, *progress)
+	}
+	return progressList, d.finished, d.err
+}
+
+// ServiceDisabled reports whether the compute service of the host has been
+// disabled yet.
+func (d *Drain) ServiceDisabled() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.disabled
+}
+
+func (d *Drain) setErr(err error) {
+	d.mu.Lock()
+	d.err = err
+	d.mu.Unlock()
+}
+
+func (d *Drain) update(progress *ServerProgress, action, status string, err error) {
+	d.mu.Lock()
+	if action != "" {
+		progress.Action = action
+	}
+	progress.Status = status
+	progress.Err = err
+	d.mu.Unlock()
+}
+
+func (d *Drain) moveServer(progress *ServerProgress, server servers.ServerWithExt, evacuate, blockMigration bool) {
+	var action string
+	var err error
+	switch {
+	case evacuate:
+		action = "evacuate"
+		err = servers.EvacuateServer(server.ID)
+	case server.Status == "ACTIVE" || server.Status == "PAUSED":
+		action = "live-migrate"
+		err = servers.LiveMigrateServer(server.ID, "", blockMigration)
+	case server.Status == "SHUTOFF":
+		action = "cold-migrate"
+		err = servers.MigrateServer(server.ID, "")
+	default:
+		d.update(progress, "skip", "skipped", fmt.Errorf("cannot move a server in status %s", server.Status))
+		return
+	}
+	if err != nil {
+		d.update(progress, action, "failed", err)
+		return
+	}
+	d.update(progress, action, "running", nil)
+
+	// Confirming is asynchronous, so the server stays in VERIFY_RESIZE for a
+	// poll or two after the confirm has been accepted.
+	confirmed := false
+	deadline := time.Now().Add(moveTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(pollInterval)
+
+		current := servers.FetchServer(server.ID)
+		if current == nil {
+			continue
+		}
+
+		switch {
+		case current.Status == "ERROR":
+			d.update(progress, "", "failed", fmt.Errorf("server went to ERROR: %s", current.Fault.Message))
+			return
+		case current.Status == "VERIFY_RESIZE":
+			if confirmed {
+				d.update(progress, "", "running (confirming)", nil)
+				continue
+			}
+			if err := servers.ConfirmResizeServer(server.ID); err != nil {
+				d.update(progress, "", "failed", fmt.Errorf("confirming migration: %w", err))
+				return
+			}
+			confirmed = true
+		case current.TaskState != "":
+			d.update(progress, "", fmt.Sprintf("running (%s)", current.TaskState), nil)
+		case current.Host != d.Host:
+			d.update(progress, "", fmt.Sprintf("done (now on %s)", current.Host), nil)
+			return
+		default:
+			d.update(progress, "", "failed", fmt.Errorf("server is still on %s, the %s was rolled back", d.Host, progress.Action))
+			return
+		}
+	}
+
+	d.update(progress, "", "failed", fmt.Errorf("timed out after %s", moveTimeout))
+}
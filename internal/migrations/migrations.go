@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+)
+
+// Migration is a cold migration, live migration, resize or evacuation as
+// recorded by nova (os-migrations).
+type Migration struct {
+	ID            int    `json:"id"`
+	UUID          string `json:"uuid"`
+	InstanceUUID  string `json:"instance_uuid"`
+	MigrationType string `json:"migration_type"`
+	Status        string `json:"status"`
+	SourceCompute string `json:"source_compute"`
+	SourceNode    string `json:"source_node"`
+	DestCompute   string `json:"dest_compute"`
+	DestNode      string `json:"dest_node"`
+	DestHost      string `json:"dest_host"`
+	OldFlavorID   int    `json:"old_instance_type_id"`
+	NewFlavorID   int    `json:"new_instance_type_id"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+// FetchMigrations retrieves up to limit migrations changed since
+// changesSince, newest first, optionally filtered by host (source or
+// destination) and status (admin). Pass the UUID of the last migration
+// already fetched as marker to get the next page.
+func FetchMigrations(host, status string, changesSince time.Time, marker string, limit int) []Migration {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// Migration UUIDs, paging and newest-first ordering are available from 2.59; the
+	// os-migrations API is not wrapped by gophercloud.
+	client.Microversion = "2.59"
+
+	query := url.Values{}
+	if host != "" {
+		query.Set("host", host)
+	}
+	if status != "" {
+		query.Set("status", status)
+	}
+	if !changesSince.IsZero() {
+		query.Set("changes-since", changesSince.UTC().Format(time.RFC3339))
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var body struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	_, err = client.Get(client.ServiceURL("os-migrations")+"?"+query.Encode(), &body, nil)
+	if err != nil {
+		fmt.Println("Failed to list migrations:", err)
+		return nil
+	}
+
+	return body.Migrations
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 )
 
 // FetchNetworks retrieves a list of OpenStack networks.
@@ -80,3 +82,100 @@ func FetchNetworkByID(networkID string) *networks.Network {
 
 	return network
 }
+
+// FetchAvailablePorts retrieves the ports of a project that are not bound to any device.
+func FetchAvailablePorts(projectId string) []ports.Port {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create network client:", err)
+		return nil
+	}
+
+	allPages, err := ports.List(client, ports.ListOpts{
+		ProjectID: projectId,
+	}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list ports:", err)
+		return nil
+	}
+
+	portList, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract ports:", err)
+		return nil
+	}
+
+	var availablePorts []ports.Port
+	for _, port := range portList {
+		if port.DeviceID == "" {
+			availablePorts = append(availablePorts, port)
+		}
+	}
+
+	return availablePorts
+}
+
+// FetchAttachableNetworks retrieves the networks a server of the project can
+// get a new port on: the project's own networks plus shared and external ones.
+func FetchAttachableNetworks(projectId string) []networks.Network {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create network client:", err)
+		return nil
+	}
+
+	allPages, err := networks.List(client, networks.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list networks:", err)
+		return nil
+	}
+
+	var networkList []struct {
+		networks.Network
+		external.NetworkExternalExt
+	}
+	err = networks.ExtractNetworksInto(allPages, &networkList)
+	if err != nil {
+		fmt.Println("Failed to extract networks:", err)
+		return nil
+	}
+
+	var attachableNetworks []networks.Network
+	for _, network := range networkList {
+		if network.ProjectID == projectId || network.Shared || network.External {
+			attachableNetworks = append(attachableNetworks, network.Network)
+		}
+	}
+
+	return attachableNetworks
+}
@@ -0,0 +1,269 @@
+package placement
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
+)
+
+// ResourceProviderDetails gathers everything placement knows about a single
+// resource provider.
+type ResourceProviderDetails struct {
+	Inventories map[string]resourceproviders.Inventory
+	Usages      map[string]int
+	Traits      []string
+	Aggregates  []string
+}
+
+// ConsumerAllocations are the resources a consumer (usually a server) holds
+// on each resource provider, keyed by provider UUID.
+type ConsumerAllocations struct {
+	Allocations map[string]resourceproviders.Allocation `json:"allocations"`
+	ProjectID   string                                  `json:"project_id"`
+	UserID      string                                  `json:"user_id"`
+}
+
+// FetchResourceProviders retrieves all placement resource providers (admin).
+func FetchResourceProviders() []resourceproviders.ResourceProvider {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewPlacementV1(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create placement client:", err)
+		return nil
+	}
+	// Parent and root provider UUIDs are returned from 1.14.
+	client.Microversion = "1.14"
+
+	allPages, err := resourceproviders.List(client, resourceproviders.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list resource providers:", err)
+		return nil
+	}
+
+	providerList, err := resourceproviders.ExtractResourceProviders(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract resource providers:", err)
+		return nil
+	}
+
+	return providerList
+}
+
+// FetchResourceProviderDetails retrieves the inventories, usages, traits and
+// aggregates of a resource provider using a single authenticated client.
+func FetchResourceProviderDetails(providerID string) *ResourceProviderDetails {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewPlacementV1(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create placement client:", err)
+		return nil
+	}
+	// Provider aggregates need at least 1.1.
+	client.Microversion = "1.14"
+
+	inventories, err := resourceproviders.GetInventories(client, providerID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get resource provider inventories:", err)
+		return nil
+	}
+
+	usages, err := resourceproviders.GetUsages(client, providerID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get resource provider usages:", err)
+		return nil
+	}
+
+	traits, err := resourceproviders.GetTraits(client, providerID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get resource provider traits:", err)
+		return nil
+	}
+
+	// Provider aggregates are not wrapped by gophercloud.
+	var aggregates struct {
+		Aggregates []string `json:"aggregates"`
+	}
+	_, err = client.Get(client.ServiceURL("resource_providers", providerID, "aggregates"), &aggregates, nil)
+	if err != nil {
+		fmt.Println("Failed to get resource provider aggregates:", err)
+		return nil
+	}
+
+	return &ResourceProviderDetails{
+		Inventories: inventories.Inventories,
+		Usages:      usages.Usages,
+		Traits:      traits.Traits,
+		Aggregates:  aggregates.Aggregates,
+	}
+}
+
+// FetchConsumerAllocations retrieves the allocations held by a consumer, such
+// as a server, across all resource providers.
+func FetchConsumerAllocations(consumerID string) (*ConsumerAllocations, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil, err
+	}
+
+	client, err := openstack.NewPlacementV1(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create placement client:", err)
+		return nil, err
+	}
+	// Project and user IDs are returned from 1.12.
+	client.Microversion = "1.14"
+
+	var allocations ConsumerAllocations
+	_, err = client.Get(client.ServiceURL("allocations", consumerID), &allocations, nil)
+	if err != nil {
+		fmt.Println("Failed to get consumer allocations:", err)
+		return nil, err
+	}
+
+	return &allocations, nil
+}
+
+// FetchResourceProviderCapacities retrieves the inventories and usages of every
+// resource provider, keyed by provider name, using a single authenticated
+// client. Traits and aggregates are left empty.
+func FetchResourceProviderCapacities() map[string]*ResourceProviderDetails {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewPlacementV1(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create placement client:", err)
+		return nil
+	}
+	client.Microversion = "1.14"
+
+	allPages, err := resourceproviders.List(client, resourceproviders.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list resource providers:", err)
+		return nil
+	}
+
+	providerList, err := resourceproviders.ExtractResourceProviders(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract resource providers:", err)
+		return nil
+	}
+
+	capacities := map[string]*ResourceProviderDetails{}
+	for _, resourceProvider := range providerList {
+		inventories, err := resourceproviders.GetInventories(client, resourceProvider.UUID).Extract()
+		if err != nil {
+			fmt.Println("Failed to get resource provider inventories:", err)
+			continue
+		}
+
+		usages, err := resourceproviders.GetUsages(client, resourceProvider.UUID).Extract()
+		if err != nil {
+			fmt.Println("Failed to get resource provider usages:", err)
+			continue
+		}
+
+		capacities[resourceProvider.Name] = &ResourceProviderDetails{
+			Inventories: inventories.Inventories,
+			Usages:      usages.Usages,
+		}
+	}
+
+	return capacities
+}
+
+// Capacity is the amount of a resource class the scheduler can allocate on
+// the provider, (total - reserved) × allocation ratio, or 0 when the provider
+// has no inventory of that class.
+func (d *ResourceProviderDetails) Capacity(class string) int {
+	inventory, ok := d.Inventories[class]
+	if !ok {
+		return 0
+	}
+	return int(float32(inventory.Total-inventory.Reserved) * inventory.AllocationRatio)
+}
+
+// Fits returns how many times the requested resources (e.g. VCPU, MEMORY_MB and
+// DISK_GB of a flavor) still fit on the provider, honouring reserved amounts,
+// allocation ratios and max unit, along with the resource class that limits it.
+func (d *ResourceProviderDetails) Fits(resources map[string]int) (int, string) {
+	fits, limitedBy := -1, ""
+	for class, amount := range resources {
+		if amount <= 0 {
+			continue
+		}
+
+		inventory, ok := d.Inventories[class]
+		if !ok || amount > inventory.MaxUnit {
+			return 0, class
+		}
+
+		free := d.Capacity(class) - d.Usages[class]
+		if free < 0 {
+			free = 0
+		}
+
+		if count := free / amount; fits < 0 || count < fits {
+			fits, limitedBy = count, class
+		}
+	}
+
+	if fits < 0 {
+		return 0, ""
+	}
+
+	return fits, limitedBy
+}
@@ -0,0 +1,282 @@
+package qos
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/qos"
+)
+
+// FetchQoSSpecs retrieves the block storage QoS specs (admin).
+func FetchQoSSpecs() []qos.QoS {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := qos.List(client, qos.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list QoS specs:", err)
+		return nil
+	}
+
+	specList, err := qos.ExtractQoS(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract QoS specs:", err)
+		return nil
+	}
+
+	return specList
+}
+
+// FetchQoSAssociations retrieves the volume types a QoS spec is associated with.
+func FetchQoSAssociations(qosID string) []qos.QosAssociation {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := qos.ListAssociations(client, qosID).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list QoS associations:", err)
+		return nil
+	}
+
+	associations, err := qos.ExtractAssociations(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract QoS associations:", err)
+		return nil
+	}
+
+	return associations
+}
+
+// CreateQoSSpec creates a QoS spec enforced by consumer, with its initial specs.
+func CreateQoSSpec(name string, consumer qos.QoSConsumer, specs map[string]string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = qos.Create(client, qos.CreateOpts{
+		Name:     name,
+		Consumer: consumer,
+		Specs:    specs,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create QoS spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetQoSSpecKey creates or updates a single key of a QoS spec.
+func SetQoSSpecKey(qosID, key, value string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = qos.Update(client, qosID, qos.UpdateOpts{Specs: map[string]string{key: value}}).Extract()
+	if err != nil {
+		fmt.Println("Failed to update QoS spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteQoSSpecKey removes a single key from a QoS spec.
+func DeleteQoSSpecKey(qosID, key string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = qos.DeleteKeys(client, qosID, qos.DeleteKeysOpts{key}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete QoS spec key:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteQoSSpec deletes a QoS spec. Cinder refuses this while it is associated with a volume type.
+func DeleteQoSSpec(qosID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = qos.Delete(client, qosID, qos.DeleteOpts{}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete QoS spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// AssociateQoSSpec associates a QoS spec with a volume type.
+func AssociateQoSSpec(qosID, volumeTypeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = qos.Associate(client, qosID, qos.AssociateOpts{VolumeTypeID: volumeTypeID}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to associate QoS spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DisassociateQoSSpec removes the association between a QoS spec and a volume type.
+func DisassociateQoSSpec(qosID, volumeTypeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = qos.Disassociate(client, qosID, qos.DisassociateOpts{VolumeTypeID: volumeTypeID}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to disassociate QoS spec:", err)
+		return err
+	}
+
+	return nil
+}
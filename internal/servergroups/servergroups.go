@@ -0,0 +1,97 @@
+package servergroups
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+)
+
+// FetchServerGroups retrieves the server groups of the current project, or of
+// every project when allProjects is set (admin only).
+func FetchServerGroups(allProjects bool) []servergroups.ServerGroup {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// 2.64 returns a single policy along with its rules.
+	client.Microversion = "2.64"
+
+	allPages, err := servergroups.List(client, servergroups.ListOpts{AllProjects: allProjects}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list server groups:", err)
+		return nil
+	}
+
+	serverGroupList, err := servergroups.ExtractServerGroups(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract server groups:", err)
+		return nil
+	}
+
+	return serverGroupList
+}
+
+// Policy returns the policy of a server group regardless of the microversion
+// it was fetched with.
+func Policy(serverGroup servergroups.ServerGroup) string {
+	if serverGroup.Policy != nil {
+		return *serverGroup.Policy
+	}
+	if len(serverGroup.Policies) > 0 {
+		return serverGroup.Policies[0]
+	}
+	return ""
+}
+
+// PolicyViolations checks the hosts the members of a server group live on
+// against its policy. hosts maps member IDs to their compute host.
+func PolicyViolations(serverGroup servergroups.ServerGroup, hosts map[string]string) []string {
+	membersByHost := map[string][]string{}
+	for _, member := range serverGroup.Members {
+		if host := hosts[member]; host != "" {
+			membersByHost[host] = append(membersByHost[host], member)
+		}
+	}
+
+	maxPerHost := 1
+	if serverGroup.Rules != nil && serverGroup.Rules.MaxServerPerHost > 0 {
+		maxPerHost = serverGroup.Rules.MaxServerPerHost
+	}
+
+	var violations []string
+	switch policy := Policy(serverGroup); policy {
+	case "anti-affinity", "soft-anti-affinity":
+		for host, members := range membersByHost {
+			if len(members) > maxPerHost {
+				violations = append(violations, fmt.Sprintf("%s: %d members share host %s", policy, len(members), host))
+			}
+		}
+	case "affinity", "soft-affinity":
+		if len(membersByHost) > 1 {
+			violations = append(violations, fmt.Sprintf("%s: members are spread over %d hosts", policy, len(membersByHost)))
+		}
+	}
+	sort.Strings(violations)
+
+	return violations
+}
@@ -6,10 +6,97 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedserverattributes"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/extendedstatus"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/instanceactions"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrate"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/rescueunrescue"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 	openstack_servers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 )
 
-func FetchServers() []openstack_servers.Server {
+// ServerWithExt is a server along with its extended status (task and VM state)
+// and extended server attributes (host placement).
+type ServerWithExt struct {
+	openstack_servers.Server
+	extendedstatus.ServerExtendedStatusExt
+	extendedserverattributes.ServerAttributesExt
+}
+
+// ServerMigration represents an in-progress live migration of a server.
+type ServerMigration struct {
+	ID                   int    `json:"id"`
+	ServerUUID           string `json:"server_uuid"`
+	Status               string `json:"status"`
+	SourceCompute        string `json:"source_compute"`
+	DestCompute          string `json:"dest_compute"`
+	MemoryTotalBytes     int64  `json:"memory_total_bytes"`
+	MemoryProcessedBytes int64  `json:"memory_processed_bytes"`
+	MemoryRemainingBytes int64  `json:"memory_remaining_bytes"`
+	DiskTotalBytes       int64  `json:"disk_total_bytes"`
+	DiskProcessedBytes   int64  `json:"disk_processed_bytes"`
+	DiskRemainingBytes   int64  `json:"disk_remaining_bytes"`
+	CreatedAt            string `json:"created_at"`
+	UpdatedAt            string `json:"updated_at"`
+}
+
+// ServerDiagnostics is the standardized diagnostics format returned from microversion 2.48.
+type ServerDiagnostics struct {
+	State         string            `json:"state"`
+	Driver        string            `json:"driver"`
+	Hypervisor    string            `json:"hypervisor"`
+	HypervisorOS  string            `json:"hypervisor_os"`
+	Uptime        int64             `json:"uptime"`
+	NumCPUs       int               `json:"num_cpus"`
+	NumDisks      int               `json:"num_disks"`
+	NumNICs       int               `json:"num_nics"`
+	MemoryDetails DiagnosticsMemory `json:"memory_details"`
+	CPUDetails    []DiagnosticsCPU  `json:"cpu_details"`
+	DiskDetails   []DiagnosticsDisk `json:"disk_details"`
+	NICDetails    []DiagnosticsNIC  `json:"nic_details"`
+}
+
+// DiagnosticsMemory holds memory counters in MB.
+type DiagnosticsMemory struct {
+	Maximum int64 `json:"maximum"`
+	Used    int64 `json:"used"`
+}
+
+// DiagnosticsCPU holds the counters of a single virtual CPU.
+type DiagnosticsCPU struct {
+	ID          int    `json:"id"`
+	Time        int64  `json:"time"`
+	Utilisation *int64 `json:"utilisation"`
+}
+
+// DiagnosticsDisk holds the counters of a single disk.
+type DiagnosticsDisk struct {
+	ReadBytes     int64 `json:"read_bytes"`
+	ReadRequests  int64 `json:"read_requests"`
+	WriteBytes    int64 `json:"write_bytes"`
+	WriteRequests int64 `json:"write_requests"`
+	ErrorsCount   int64 `json:"errors_count"`
+}
+
+// DiagnosticsNIC holds the counters of a single network interface.
+type DiagnosticsNIC struct {
+	MacAddress string `json:"mac_address"`
+	RxOctets   int64  `json:"rx_octets"`
+	RxPackets  int64  `json:"rx_packets"`
+	RxErrors   int64  `json:"rx_errors"`
+	RxDrop     int64  `json:"rx_drop"`
+	TxOctets   int64  `json:"tx_octets"`
+	TxPackets  int64  `json:"tx_packets"`
+	TxErrors   int64  `json:"tx_errors"`
+	TxDrop     int64  `json:"tx_drop"`
+}
+
+// FetchServers retrieves the servers matching listOpts. Set AllTenants to list
+// servers across every project (admin only).
+func FetchServers(listOpts openstack_servers.ListOpts) []ServerWithExt {
 	opts := gophercloud.AuthOptions{
 		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
 		Username:         os.Getenv("OS_USERNAME"),
@@ -30,16 +117,1005 @@ func FetchServers() []openstack_servers.Server {
 	if err != nil {
 		fmt.Println("Failed to create compute client: ", err)
 	}
+	// Filtering by tags requires 2.26.
+	client.Microversion = "2.26"
 
-	allPages, err := openstack_servers.List(client, nil).AllPages()
+	allPages, err := openstack_servers.List(client, listOpts).AllPages()
 	if err != nil {
 		fmt.Println("Failed to list servers: ", err)
 	}
 
-	serverList, err := openstack_servers.ExtractServers(allPages)
+	var serverList []ServerWithExt
+	err = openstack_servers.ExtractServersInto(allPages, &serverList)
 	if err != nil || len(serverList) == 0 {
 		fmt.Println("No servers found or extract failed: ", err)
 	}
 
 	return serverList
 }
+
+// FetchInstanceActions retrieves the action history (os-instance-actions) of a server.
+func FetchInstanceActions(serverID string) []instanceactions.InstanceAction {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := instanceactions.List(client, serverID, nil).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list instance actions:", err)
+		return nil
+	}
+
+	actionList, err := instanceactions.ExtractInstanceActions(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract instance actions:", err)
+		return nil
+	}
+
+	return actionList
+}
+
+// FetchInstanceActionByRequestID retrieves a single instance action including its events.
+func FetchInstanceActionByRequestID(serverID, requestID string) *instanceactions.InstanceActionDetail {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// Events are only returned to non-admin users from 2.51 onwards.
+	client.Microversion = "2.51"
+
+	action, err := instanceactions.Get(client, serverID, requestID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get instance action details:", err)
+		return nil
+	}
+
+	return &action
+}
+
+// FetchServer retrieves a single server with its task state and host.
+func FetchServer(serverID string) *ServerWithExt {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	var server ServerWithExt
+	err = openstack_servers.Get(client, serverID).ExtractInto(&server)
+	if err != nil {
+		fmt.Println("Failed to get server details:", err)
+		return nil
+	}
+
+	return &server
+}
+
+// FetchServerMigrations retrieves the in-progress live migrations of a server.
+func FetchServerMigrations(serverID string) []ServerMigration {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// The server migrations API was added in 2.23 and is not wrapped by gophercloud.
+	client.Microversion = "2.23"
+
+	var body struct {
+		Migrations []ServerMigration `json:"migrations"`
+	}
+	_, err = client.Get(client.ServiceURL("servers", serverID, "migrations"), &body, nil)
+	if err != nil {
+		fmt.Println("Failed to list server migrations:", err)
+		return nil
+	}
+
+	return body.Migrations
+}
+
+// ResizeServer resizes a server to a new flavor. The server ends up in
+// VERIFY_RESIZE until the resize is confirmed or reverted.
+func ResizeServer(serverID, flavorID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = openstack_servers.Resize(client, serverID, openstack_servers.ResizeOpts{FlavorRef: flavorID}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to resize server:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ConfirmResizeServer confirms a pending resize or cold migration.
+func ConfirmResizeServer(serverID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = openstack_servers.ConfirmResize(client, serverID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to confirm resize:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RevertResizeServer reverts a pending resize or cold migration.
+func RevertResizeServer(serverID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = openstack_servers.RevertResize(client, serverID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to revert resize:", err)
+		return err
+	}
+
+	return nil
+}
+
+// MigrateServer cold-migrates a server. An empty host lets the scheduler pick one.
+func MigrateServer(serverID, host string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	if host == "" {
+		err = migrate.Migrate(client, serverID).ExtractErr()
+	} else {
+		// A destination host for cold migration requires 2.56, which
+		// migrate.Migrate does not support.
+		client.Microversion = "2.56"
+		_, err = client.Post(client.ServiceURL("servers", serverID, "action"), map[string]interface{}{
+			"migrate": map[string]interface{}{
+				"host": host,
+			},
+		}, nil, &gophercloud.RequestOpts{
+			OkCodes: []int{202},
+		})
+	}
+	if err != nil {
+		fmt.Println("Failed to migrate server:", err)
+		return err
+	}
+
+	return nil
+}
+
+// LiveMigrateServer live-migrates a server. An empty host lets the scheduler pick one.
+func LiveMigrateServer(serverID, host string, blockMigration bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	// 2.25 drops disk_over_commit and makes the host optional.
+	client.Microversion = "2.25"
+
+	migrateOpts := migrate.LiveMigrateOpts{
+		BlockMigration: &blockMigration,
+	}
+	if host != "" {
+		migrateOpts.Host = &host
+	}
+
+	err = migrate.LiveMigrate(client, serverID, migrateOpts).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to live migrate server:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RebuildServer reprovisions a server from the given image.
+func RebuildServer(serverID, imageID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = openstack_servers.Rebuild(client, serverID, openstack_servers.RebuildOpts{ImageRef: imageID}).Extract()
+	if err != nil {
+		fmt.Println("Failed to rebuild server:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RescueServer puts a server into rescue mode and returns the rescue admin password.
+// An empty imageID boots the rescue instance from the server's own image.
+func RescueServer(serverID, imageID string) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return "", err
+	}
+
+	adminPass, err := rescueunrescue.Rescue(client, serverID, rescueunrescue.RescueOpts{RescueImageRef: imageID}).Extract()
+	if err != nil {
+		fmt.Println("Failed to rescue server:", err)
+		return "", err
+	}
+
+	return adminPass, nil
+}
+
+// UnrescueServer takes a server out of rescue mode.
+func UnrescueServer(serverID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = rescueunrescue.Unrescue(client, serverID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to unrescue server:", err)
+		return err
+	}
+
+	return nil
+}
+
+// CreateServerSnapshot creates an image from a server and returns the new image ID.
+func CreateServerSnapshot(serverID, name string) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return "", err
+	}
+
+	imageID, err := openstack_servers.CreateImage(client, serverID, openstack_servers.CreateImageOpts{Name: name}).ExtractImageID()
+	if err != nil {
+		fmt.Println("Failed to create server snapshot:", err)
+		return "", err
+	}
+
+	return imageID, nil
+}
+
+// FetchServerMetadata retrieves the metadata key/value pairs of a server.
+func FetchServerMetadata(serverID string) map[string]string {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	metadata, err := openstack_servers.Metadata(client, serverID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get server metadata:", err)
+		return nil
+	}
+
+	return metadata
+}
+
+// SetServerMetadatum creates or updates a single metadata key on a server.
+func SetServerMetadatum(serverID, key, value string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = openstack_servers.CreateMetadatum(client, serverID, openstack_servers.MetadatumOpts{key: value}).Extract()
+	if err != nil {
+		fmt.Println("Failed to set server metadata:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteServerMetadatum removes a single metadata key from a server.
+func DeleteServerMetadatum(serverID, key string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = openstack_servers.DeleteMetadatum(client, serverID, key).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete server metadata:", err)
+		return err
+	}
+
+	return nil
+}
+
+// FetchServerTags retrieves the tags of a server.
+func FetchServerTags(serverID string) []string {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	client.Microversion = "2.26"
+
+	tagList, err := tags.List(client, serverID).Extract()
+	if err != nil {
+		fmt.Println("Failed to list server tags:", err)
+		return nil
+	}
+
+	return tagList
+}
+
+// AddServerTag adds a tag to a server.
+func AddServerTag(serverID, tag string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.26"
+
+	err = tags.Add(client, serverID, tag).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to add server tag:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteServerTag removes a tag from a server.
+func DeleteServerTag(serverID, tag string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.26"
+
+	err = tags.Delete(client, serverID, tag).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete server tag:", err)
+		return err
+	}
+
+	return nil
+}
+
+// FetchServerInterfaces retrieves the network interfaces attached to a server.
+func FetchServerInterfaces(serverID string) []attachinterfaces.Interface {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := attachinterfaces.List(client, serverID).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list server interfaces:", err)
+		return nil
+	}
+
+	interfaceList, err := attachinterfaces.ExtractInterfaces(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract server interfaces:", err)
+		return nil
+	}
+
+	return interfaceList
+}
+
+// AttachServerInterface attaches an existing port, or a new port on the given
+// network, to a server.
+func AttachServerInterface(serverID, portID, networkID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = attachinterfaces.Create(client, serverID, attachinterfaces.CreateOpts{
+		PortID:    portID,
+		NetworkID: networkID,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to attach interface:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DetachServerInterface detaches a port from a server.
+func DetachServerInterface(serverID, portID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = attachinterfaces.Delete(client, serverID, portID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to detach interface:", err)
+		return err
+	}
+
+	return nil
+}
+
+// FetchServerVolumeAttachments retrieves the volumes attached to a server.
+func FetchServerVolumeAttachments(serverID string) []volumeattach.VolumeAttachment {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := volumeattach.List(client, serverID).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volume attachments:", err)
+		return nil
+	}
+
+	attachmentList, err := volumeattach.ExtractVolumeAttachments(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volume attachments:", err)
+		return nil
+	}
+
+	return attachmentList
+}
+
+// AttachServerVolume attaches a volume to a server, letting Nova pick the device.
+func AttachServerVolume(serverID, volumeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	_, err = volumeattach.Create(client, serverID, volumeattach.CreateOpts{VolumeID: volumeID}).Extract()
+	if err != nil {
+		fmt.Println("Failed to attach volume:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DetachServerVolume detaches a volume from a server.
+func DetachServerVolume(serverID, volumeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+
+	err = volumeattach.Delete(client, serverID, volumeID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to detach volume:", err)
+		return err
+	}
+
+	return nil
+}
+
+// FetchServerDiagnostics retrieves the CPU, memory, disk and NIC counters of a server (admin only).
+func FetchServerDiagnostics(serverID string) *ServerDiagnostics {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// Diagnostics are hypervisor specific before 2.48.
+	client.Microversion = "2.48"
+
+	var serverDiagnostics ServerDiagnostics
+	err = diagnostics.Get(client, serverID).ExtractInto(&serverDiagnostics)
+	if err != nil {
+		fmt.Println("Failed to get server diagnostics:", err)
+		return nil
+	}
+
+	return &serverDiagnostics
+}
+
+// EvacuateServer rebuilds a server from a down compute host on a
+// scheduler-selected host.
+func EvacuateServer(serverID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	// From 2.14 Nova detects shared storage itself. The gophercloud evacuate
+	// request always sends onSharedStorage, which 2.14 rejects, so post directly.
+	client.Microversion = "2.14"
+
+	_, err = client.Post(client.ServiceURL("servers", serverID, "action"), map[string]interface{}{
+		"evacuate": map[string]interface{}{},
+	}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		fmt.Println("Failed to evacuate server:", err)
+		return err
+	}
+
+	return nil
+}
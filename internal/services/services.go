@@ -0,0 +1,162 @@
+package services
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+)
+
+// FetchServices retrieves the compute services (os-services), optionally
+// filtered by binary and host.
+func FetchServices(binary, host string) []services.Service {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+	// Service IDs are UUIDs from 2.53, which is what Update expects.
+	client.Microversion = "2.53"
+
+	allPages, err := services.List(client, services.ListOpts{Binary: binary, Host: host}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list services:", err)
+		return nil
+	}
+
+	serviceList, err := services.ExtractServices(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract services:", err)
+		return nil
+	}
+
+	return serviceList
+}
+
+// DisableService disables a compute service so the scheduler stops placing servers on it.
+func DisableService(serviceID, reason string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.53"
+
+	_, err = services.Update(client, serviceID, services.UpdateOpts{
+		Status:         services.ServiceDisabled,
+		DisabledReason: reason,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to disable service:", err)
+		return err
+	}
+
+	return nil
+}
+
+// EnableService re-enables a compute service.
+func EnableService(serviceID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.53"
+
+	_, err = services.Update(client, serviceID, services.UpdateOpts{
+		Status: services.ServiceEnabled,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to enable service:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetServiceForcedDown marks a compute service as forced down (or clears it)
+// so servers on a dead host can be evacuated without waiting for the heartbeat
+// timeout.
+func SetServiceForcedDown(serviceID string, forcedDown bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return err
+	}
+	client.Microversion = "2.53"
+
+	// services.UpdateOpts omits forced_down when false, so it cannot be cleared through it.
+	_, err = client.Put(client.ServiceURL("os-services", serviceID), map[string]interface{}{
+		"forced_down": forcedDown,
+	}, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		fmt.Println("Failed to update service:", err)
+		return err
+	}
+
+	return nil
+}
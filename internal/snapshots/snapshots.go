@@ -0,0 +1,150 @@
+package snapshots
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+)
+
+// FetchSnapshots retrieves the volume snapshots of the current project,
+// limited to those of volumeID when it is set.
+func FetchSnapshots(volumeID string) []snapshots.Snapshot {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := snapshots.List(client, snapshots.ListOpts{VolumeID: volumeID}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list snapshots:", err)
+		return nil
+	}
+
+	snapshotList, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract snapshots:", err)
+		return nil
+	}
+
+	return snapshotList
+}
+
+// FetchSnapshotByID retrieves a single snapshot by its ID.
+func FetchSnapshotByID(snapshotID string) *snapshots.Snapshot {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	snapshot, err := snapshots.Get(client, snapshotID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get snapshot details:", err)
+		return nil
+	}
+
+	return snapshot
+}
+
+// CreateSnapshot snapshots a volume and returns the snapshot ID. Force is
+// needed to snapshot a volume that is attached to a server.
+func CreateSnapshot(volumeID, name string, force bool) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return "", err
+	}
+
+	snapshot, err := snapshots.Create(client, snapshots.CreateOpts{
+		VolumeID: volumeID,
+		Name:     name,
+		Force:    force,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create snapshot:", err)
+		return "", err
+	}
+
+	return snapshot.ID, nil
+}
+
+// DeleteSnapshot deletes a volume snapshot.
+func DeleteSnapshot(snapshotID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = snapshots.Delete(client, snapshotID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete snapshot:", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package transfers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+)
+
+// FetchTransfers retrieves the pending volume transfers of the current project.
+func FetchTransfers() []volumetransfers.Transfer {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := volumetransfers.List(client, volumetransfers.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volume transfers:", err)
+		return nil
+	}
+
+	transferList, err := volumetransfers.ExtractTransfers(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volume transfers:", err)
+		return nil
+	}
+
+	return transferList
+}
+
+// CreateTransfer offers a volume for transfer to another project. The
+// returned transfer carries the auth key, which cinder never shows again.
+func CreateTransfer(volumeID, name string) (*volumetransfers.Transfer, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil, err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil, err
+	}
+
+	transfer, err := volumetransfers.Create(client, volumetransfers.CreateOpts{
+		VolumeID: volumeID,
+		Name:     name,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create volume transfer:", err)
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// AcceptTransfer takes ownership of a transferred volume in the current project.
+func AcceptTransfer(transferID, authKey string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = volumetransfers.Accept(client, transferID, volumetransfers.AcceptOpts{AuthKey: authKey}).Extract()
+	if err != nil {
+		fmt.Println("Failed to accept volume transfer:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTransfer cancels a pending volume transfer.
+func DeleteTransfer(transferID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumetransfers.Delete(client, transferID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete volume transfer:", err)
+		return err
+	}
+
+	return nil
+}
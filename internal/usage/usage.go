@@ -0,0 +1,95 @@
+package usage
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/usage"
+)
+
+// FetchAllTenantsUsage retrieves the simple tenant usage of every project
+// between start and end (admin only).
+func FetchAllTenantsUsage(start, end time.Time) []usage.TenantUsage {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := usage.AllTenants(client, usage.AllTenantsOpts{
+		Detailed: true,
+		Start:    &start,
+		End:      &end,
+	}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list usage:", err)
+		return nil
+	}
+
+	usageList, err := usage.ExtractAllTenants(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract usage:", err)
+		return nil
+	}
+
+	return usageList
+}
+
+// FetchTenantUsage retrieves the simple tenant usage of a single project between start and end.
+func FetchTenantUsage(projectID string, start, end time.Time) *usage.TenantUsage {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create compute client:", err)
+		return nil
+	}
+
+	allPages, err := usage.SingleTenant(client, projectID, usage.SingleTenantOpts{
+		Start: &start,
+		End:   &end,
+	}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to get usage:", err)
+		return nil
+	}
+
+	tenantUsage, err := usage.ExtractSingleTenant(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract usage:", err)
+		return nil
+	}
+
+	return tenantUsage
+}
@@ -6,11 +6,14 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/schedulerstats"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/services"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 )
 
-// FetchVolumes retrieves a list of volumes for a given project.
-func FetchVolumes() []volumes.Volume {
+// FetchVolumes retrieves a list of volumes matching listOpts.
+func FetchVolumes(listOpts volumes.ListOpts) []volumes.Volume {
 	opts := gophercloud.AuthOptions{
 		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
 		Username:         os.Getenv("OS_USERNAME"),
@@ -32,7 +35,7 @@ func FetchVolumes() []volumes.Volume {
 		return nil
 	}
 
-	allPages, err := volumes.List(client, volumes.ListOpts{}).AllPages()
+	allPages, err := volumes.List(client, listOpts).AllPages()
 	if err != nil {
 		fmt.Println("Failed to list volumes:", err)
 		return nil
@@ -121,3 +124,367 @@ func FetchVolumeByName(volumeName, projectID string) *volumes.Volume {
 
 	return &allVolumes[0] // Return the first match
 }
+
+// FetchAvailableVolumes retrieves the volumes that are not attached to anything.
+// An empty projectID means the current project; any other project needs admin.
+func FetchAvailableVolumes(projectID string) []volumes.Volume {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := volumes.List(client, volumes.ListOpts{
+		Status:     "available",
+		AllTenants: projectID != "",
+		TenantID:   projectID,
+	}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volumes:", err)
+		return nil
+	}
+
+	volumeList, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volumes:", err)
+		return nil
+	}
+
+	return volumeList
+}
+
+// FetchVolumeServices retrieves the block storage services (cinder-volume,
+// cinder-scheduler, cinder-backup) with their zone and state (admin).
+func FetchVolumeServices() []services.Service {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := services.List(client, services.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volume services:", err)
+		return nil
+	}
+
+	serviceList, err := services.ExtractServices(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volume services:", err)
+		return nil
+	}
+
+	return serviceList
+}
+
+// FetchStoragePools retrieves the scheduler's view of every backend pool with
+// its capacity details (admin).
+func FetchStoragePools() []schedulerstats.StoragePool {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := schedulerstats.List(client, schedulerstats.ListOpts{Detail: true}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list storage pools:", err)
+		return nil
+	}
+
+	poolList, err := schedulerstats.ExtractStoragePools(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract storage pools:", err)
+		return nil
+	}
+
+	return poolList
+}
+
+// CreateVolume creates a volume, empty or from an image or snapshot, and returns its ID.
+func CreateVolume(name string, size int, volumeType, availabilityZone, imageID, snapshotID string) (string, error) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return "", err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return "", err
+	}
+
+	volume, err := volumes.Create(client, volumes.CreateOpts{
+		Name:             name,
+		Size:             size,
+		VolumeType:       volumeType,
+		AvailabilityZone: availabilityZone,
+		ImageID:          imageID,
+		SnapshotID:       snapshotID,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create volume:", err)
+		return "", err
+	}
+
+	return volume.ID, nil
+}
+
+// ExtendVolume grows a volume to newSize GB, attached or not.
+func ExtendVolume(volumeID string, newSize int) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+	// Extending an in-use volume requires 3.42.
+	client.Microversion = "3.42"
+
+	err = volumeactions.ExtendSize(client, volumeID, volumeactions.ExtendSizeOpts{NewSize: newSize}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to extend volume:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RetypeVolume changes the type of a volume. With the on-demand migration
+// policy cinder moves the data when the new type lives on another backend.
+func RetypeVolume(volumeID, newType string, migrationPolicy volumeactions.MigrationPolicy) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumeactions.ChangeType(client, volumeID, volumeactions.ChangeTypeOpts{
+		NewType:         newType,
+		MigrationPolicy: migrationPolicy,
+	}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to retype volume:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetVolumeBootable sets or clears the bootable flag of a volume.
+func SetVolumeBootable(volumeID string, bootable bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumeactions.SetBootable(client, volumeID, volumeactions.BootableOpts{Bootable: bootable}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to set volume bootable flag:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetVolumeReadOnly sets or clears the read-only flag of a volume.
+func SetVolumeReadOnly(volumeID string, readOnly bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	// The read-only flag action is not wrapped by gophercloud.
+	body := map[string]interface{}{
+		"os-update_readonly_flag": map[string]interface{}{"readonly": readOnly},
+	}
+	_, err = client.Post(client.ServiceURL("volumes", volumeID, "action"), body, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		fmt.Println("Failed to set volume read-only flag:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ResetVolumeStatus overrides the status cinder has recorded for a volume (admin).
+func ResetVolumeStatus(volumeID, status string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumeactions.ResetStatus(client, volumeID, volumeactions.ResetStatusOpts{Status: status}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to reset volume status:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteVolume deletes a volume.
+func DeleteVolume(volumeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumes.Delete(client, volumeID, volumes.DeleteOpts{}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete volume:", err)
+		return err
+	}
+
+	return nil
+}
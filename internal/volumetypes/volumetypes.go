@@ -0,0 +1,387 @@
+package volumetypes
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+// VolumeTypeDetails holds the settings of a volume type that are not part of
+// the type listing.
+type VolumeTypeDetails struct {
+	// ProjectIDs are the projects allowed to use a private type.
+	ProjectIDs []string
+	// Encryption is nil when the type is not encrypted.
+	Encryption *volumetypes.GetEncryptionType
+}
+
+// FetchVolumeTypes retrieves the volume types visible to the current project.
+func FetchVolumeTypes() []volumetypes.VolumeType {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	allPages, err := volumetypes.List(client, volumetypes.ListOpts{}).AllPages()
+	if err != nil {
+		fmt.Println("Failed to list volume types:", err)
+		return nil
+	}
+
+	typeList, err := volumetypes.ExtractVolumeTypes(allPages)
+	if err != nil {
+		fmt.Println("Failed to extract volume types:", err)
+		return nil
+	}
+
+	return typeList
+}
+
+// FetchAllVolumeTypes retrieves every volume type, public and private (admin).
+func FetchAllVolumeTypes() []volumetypes.VolumeType {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	// volumetypes.ListOpts cannot ask for private types, which cinder only
+	// includes for admins with is_public=None.
+	var body struct {
+		VolumeTypes []volumetypes.VolumeType `json:"volume_types"`
+	}
+	_, err = client.Get(client.ServiceURL("types")+"?is_public=None", &body, nil)
+	if err != nil {
+		fmt.Println("Failed to list volume types:", err)
+		return nil
+	}
+
+	sort.Slice(body.VolumeTypes, func(i, j int) bool {
+		return body.VolumeTypes[i].Name < body.VolumeTypes[j].Name
+	})
+
+	return body.VolumeTypes
+}
+
+// FetchVolumeTypeDetails retrieves the project access list of a private
+// volume type and the encryption settings of a volume type.
+func FetchVolumeTypeDetails(volumeTypeID string, public bool) *VolumeTypeDetails {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return nil
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return nil
+	}
+
+	details := &VolumeTypeDetails{}
+	if !public {
+		allPages, err := volumetypes.ListAccesses(client, volumeTypeID).AllPages()
+		if err != nil {
+			fmt.Println("Failed to list volume type access:", err)
+			return nil
+		}
+
+		accesses, err := volumetypes.ExtractAccesses(allPages)
+		if err != nil {
+			fmt.Println("Failed to extract volume type access:", err)
+			return nil
+		}
+
+		for _, access := range accesses {
+			details.ProjectIDs = append(details.ProjectIDs, access.ProjectID)
+		}
+	}
+
+	encryption, err := volumetypes.GetEncryption(client, volumeTypeID).Extract()
+	if err != nil {
+		fmt.Println("Failed to get volume type encryption:", err)
+		return nil
+	}
+	// Unencrypted types return an empty encryption object.
+	if encryption.EncryptionID != "" {
+		details.Encryption = encryption
+	}
+
+	return details
+}
+
+// CreateVolumeType creates a volume type.
+func CreateVolumeType(name, description string, public bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = volumetypes.Create(client, volumetypes.CreateOpts{
+		Name:        name,
+		Description: description,
+		IsPublic:    &public,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to create volume type:", err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdateVolumeType changes the name, description and visibility of a volume type.
+func UpdateVolumeType(volumeTypeID, name, description string, public bool) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = volumetypes.Update(client, volumeTypeID, volumetypes.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+		IsPublic:    &public,
+	}).Extract()
+	if err != nil {
+		fmt.Println("Failed to update volume type:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteVolumeType deletes a volume type. Cinder refuses this while volumes use it.
+func DeleteVolumeType(volumeTypeID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumetypes.Delete(client, volumeTypeID).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete volume type:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SetVolumeTypeExtraSpec creates or updates a single extra spec of a volume type.
+func SetVolumeTypeExtraSpec(volumeTypeID, key, value string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	_, err = volumetypes.CreateExtraSpecs(client, volumeTypeID, volumetypes.ExtraSpecsOpts{key: value}).Extract()
+	if err != nil {
+		fmt.Println("Failed to set volume type extra spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteVolumeTypeExtraSpec removes a single extra spec from a volume type.
+func DeleteVolumeTypeExtraSpec(volumeTypeID, key string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumetypes.DeleteExtraSpec(client, volumeTypeID, key).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to delete volume type extra spec:", err)
+		return err
+	}
+
+	return nil
+}
+
+// AddVolumeTypeAccess allows a project to use a private volume type.
+func AddVolumeTypeAccess(volumeTypeID, projectID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumetypes.AddAccess(client, volumeTypeID, volumetypes.AddAccessOpts{Project: projectID}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to add volume type access:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveVolumeTypeAccess revokes a project's access to a private volume type.
+func RemoveVolumeTypeAccess(volumeTypeID, projectID string) error {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: os.Getenv("OS_AUTH_URL"),
+		Username:         os.Getenv("OS_USERNAME"),
+		Password:         os.Getenv("OS_PASSWORD"),
+		DomainName:       os.Getenv("OS_USER_DOMAIN_NAME"),
+		TenantName:       os.Getenv("OS_PROJECT_NAME"),
+		AllowReauth:      true,
+	}
+
+	provider, err := openstack.AuthenticatedClient(opts)
+	if err != nil {
+		fmt.Println("Failed to authenticate:", err)
+		return err
+	}
+
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		fmt.Println("Failed to create block storage client:", err)
+		return err
+	}
+
+	err = volumetypes.RemoveAccess(client, volumeTypeID, volumetypes.RemoveAccessOpts{Project: projectID}).ExtractErr()
+	if err != nil {
+		fmt.Println("Failed to remove volume type access:", err)
+		return err
+	}
+
+	return nil
+}
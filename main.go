@@ -2,31 +2,72 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
+	openstack_backups "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	openstack_schedulerstats "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/schedulerstats"
+	openstack_volume_services "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/services"
+	openstack_volumeactions "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	openstack_volumetransfers "github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumetransfers"
+	openstack_qos "github.com/gophercloud/gophercloud/openstack/blockstorage/v3/qos"
+	openstack_snapshots "github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	openstack_volumes "github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	openstack_volumetypes "github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	openstack_aggregates "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	openstack_hypervisors "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+	openstack_services "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	openstack_flavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	openstack_servers "github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	openstack_images "github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	openstack_resourceproviders "github.com/gophercloud/gophercloud/openstack/placement/v1/resourceproviders"
 	"github.com/neilfarmer/internal/aggregates"
+	"github.com/neilfarmer/internal/availabilityzones"
+	"github.com/neilfarmer/internal/backups"
 	"github.com/neilfarmer/internal/dns"
 	"github.com/neilfarmer/internal/flavors"
 	"github.com/neilfarmer/internal/hypervisors"
 	projects "github.com/neilfarmer/internal/identity"
 	"github.com/neilfarmer/internal/images"
+	"github.com/neilfarmer/internal/keypairs"
 	"github.com/neilfarmer/internal/loadbalancers"
+	"github.com/neilfarmer/internal/maintenance"
+	"github.com/neilfarmer/internal/migrations"
 	"github.com/neilfarmer/internal/networks"
+	"github.com/neilfarmer/internal/placement"
+	"github.com/neilfarmer/internal/qos"
+	"github.com/neilfarmer/internal/servergroups"
 	"github.com/neilfarmer/internal/servers"
+	"github.com/neilfarmer/internal/services"
+	"github.com/neilfarmer/internal/snapshots"
+	"github.com/neilfarmer/internal/transfers"
+	"github.com/neilfarmer/internal/usage"
 	"github.com/neilfarmer/internal/volumes"
+	"github.com/neilfarmer/internal/volumetypes"
 	"github.com/rivo/tview"
 )
 
+var app *tview.Application
 var pages *tview.Pages
 var inputPrompt *tview.InputField
 var headerFlex *tview.Flex
 var detailsView *tview.TextView
+var progressView *tview.TextView
+var hypervisorSummaryView *tview.TextView
 var aggregatesList *tview.List
 var hypervisorsList *tview.List
 var serverList *tview.List
+var serverActionsList *tview.List
+var serverMetadataList *tview.List
+var serverAttachmentsList *tview.List
 var imagesList *tview.List
 var flavorsList *tview.List
 var projectsList *tview.List
@@ -34,6 +75,134 @@ var networksList *tview.List
 var volumesList *tview.List
 var loadbalancersList *tview.List
 var dnsList *tview.List
+var keypairsList *tview.List
+var serverGroupsList *tview.List
+var usageList *tview.List
+var servicesList *tview.List
+var zonesList *tview.List
+var resourceProvidersList *tview.List
+var migrationsList *tview.List
+var snapshotsList *tview.List
+var backupsList *tview.List
+var volumeTypesList *tview.List
+var transfersList *tview.List
+var blockStorageList *tview.List
+
+// serverItems holds the servers currently shown in serverList, in list order.
+var serverItems []servers.ServerWithExt
+
+// aggregateItems holds the host aggregates currently shown in aggregatesList, in list order.
+var aggregateItems []openstack_aggregates.Aggregate
+
+// hypervisorItems holds the hypervisors currently shown in hypervisorsList, in list order.
+var hypervisorItems []openstack_hypervisors.Hypervisor
+
+// hypervisorCapacities holds the placement inventories and usages of the
+// hypervisors, keyed by provider name, once they have loaded.
+var hypervisorCapacities map[string]*placement.ResourceProviderDetails
+
+// serviceItems holds the compute services currently shown in servicesList, in list order.
+var serviceItems []openstack_services.Service
+
+// resourceProviderItems holds the placement resource providers currently shown
+// in resourceProvidersList, in list order.
+var resourceProviderItems []openstack_resourceproviders.ResourceProvider
+
+// migrationsHost and migrationsStatus filter the migrations page; empty means any.
+var migrationsHost string
+var migrationsStatus string
+
+// migrationsDays bounds the migrations page to recently changed migrations.
+var migrationsDays = 7
+
+// migrationsPageSize is how many migrations are fetched per page.
+const migrationsPageSize = 100
+
+// migrationItems holds the migrations fetched so far, and migrationServerNames
+// the names of the servers they belong to.
+var migrationItems []migrations.Migration
+var migrationServerNames map[string]string
+
+// zoneItems holds the availability zones and hosts currently shown in zonesList, in list order.
+var zoneItems []zoneEntry
+
+// zoneEntry is an availability zone, or one of its hosts when host is set.
+type zoneEntry struct {
+	compute bool
+	zone    string
+	host    string
+}
+
+// serverListOpts holds the filters applied when listing servers.
+var serverListOpts openstack_servers.ListOpts
+
+// hypervisorServersParentOpts holds the server filters to restore when leaving
+// a hypervisor drill-down; nil when the server list is not drilled down.
+var hypervisorServersParentOpts *openstack_servers.ListOpts
+
+// serverGroupsAllProjects lists server groups across every project when set.
+var serverGroupsAllProjects bool
+
+// usageStart and usageEnd bound the time window shown in the usage view, with
+// usageEnd exclusive. Both are zero until a window is picked, meaning the last
+// 30 days up to the moment the view is opened.
+var usageStart, usageEnd time.Time
+
+// serverMetadataEntry is a metadata key/value pair or a tag shown in serverMetadataList.
+type serverMetadataEntry struct {
+	tag   bool
+	key   string
+	value string
+}
+
+// metadataServer is the server whose metadata and tags are shown in serverMetadataList.
+var metadataServer openstack_servers.Server
+var serverMetadataItems []serverMetadataEntry
+
+// serverAttachmentEntry is a port or volume shown in serverAttachmentsList.
+type serverAttachmentEntry struct {
+	volume bool
+	id     string
+	name   string
+}
+
+// attachmentsServer is the server whose ports and volumes are shown in serverAttachmentsList.
+var attachmentsServer openstack_servers.Server
+var serverAttachmentItems []serverAttachmentEntry
+
+// flavorItems holds the flavors currently shown in flavorsList, in list order.
+var flavorItems []openstack_flavors.Flavor
+
+// volumeItems holds the volumes currently shown in volumesList, in list order.
+var volumeItems []openstack_volumes.Volume
+
+// volumeListOpts holds the filters applied when listing volumes.
+var volumeListOpts openstack_volumes.ListOpts
+
+// volumeOrphanDays, when set, limits volumesList to available, unattached
+// volumes created more than that many days ago.
+var volumeOrphanDays int
+
+// snapshotItems holds the volume snapshots currently shown in snapshotsList, in list order.
+var snapshotItems []openstack_snapshots.Snapshot
+
+// snapshotsVolume limits snapshotsList to the snapshots of one volume when
+// opened from the volumes view; nil lists all snapshots.
+var snapshotsVolume *openstack_volumes.Volume
+
+// backupItems holds the volume backups currently shown in backupsList, in list order.
+var backupItems []openstack_backups.Backup
+
+// volumeTypeItems and qosItems hold the volume types and QoS specs shown in
+// volumeTypesList; the QoS specs are listed after the volume types.
+var volumeTypeItems []openstack_volumetypes.VolumeType
+var qosItems []openstack_qos.QoS
+
+// transferItems holds the pending volume transfers currently shown in transfersList, in list order.
+var transferItems []openstack_volumetransfers.Transfer
+
+// imageItems holds the images currently shown in imagesList, in list order.
+var imageItems []openstack_images.Image
 
 var knownCommands = []string{
 	"servers",
@@ -46,15 +215,33 @@ var knownCommands = []string{
 	"loadbalancers",
 	"dns",
 	"networks",
+	"keypairs",
+	"servergroups",
+	"usage",
+	"services",
+	"zones",
+	"placement",
+	"migrations",
+	"snapshots",
+	"backups",
+	"volumetypes",
+	"transfers",
+	"blockstorage",
 }
 
 var acceptShortcuts = true
 
+// stopProgressWatch stops the goroutine currently refreshing progressView, if any.
+var stopProgressWatch chan struct{}
+
+// progressReturnPage is the page to go back to when leaving the progress page.
+var progressReturnPage = "servers"
+
 func main() {
 	// Root application
-	app := tview.NewApplication()
+	app = tview.NewApplication()
 	app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Rune() == ':' {
+		if acceptShortcuts && event.Rune() == ':' {
 			acceptShortcuts = false
 			headerFlex.ResizeItem(inputPrompt, 3, 1) // show prompt
 			app.SetFocus(inputPrompt)
@@ -92,6 +279,7 @@ func main() {
 				pages.SwitchToPage("volumes")
 				detailsView.Clear()
 			case 's':
+				leaveHypervisorServers()
 				populateServersList()
 				pages.SwitchToPage("servers")
 				detailsView.Clear()
@@ -99,6 +287,55 @@ func main() {
 				populateNetworksList()
 				pages.SwitchToPage("networks")
 				detailsView.Clear()
+			case 'k':
+				populateKeypairsList()
+				pages.SwitchToPage("keypairs")
+				detailsView.Clear()
+			case 'g':
+				populateServerGroupsList()
+				pages.SwitchToPage("servergroups")
+				detailsView.Clear()
+			case 'u':
+				populateUsageList()
+				pages.SwitchToPage("usage")
+				detailsView.Clear()
+			case 'c':
+				populateServicesList()
+				pages.SwitchToPage("services")
+				detailsView.Clear()
+			case 'z':
+				populateZonesList()
+				pages.SwitchToPage("zones")
+				detailsView.Clear()
+			case 'r':
+				populateResourceProvidersList()
+				pages.SwitchToPage("placement")
+				detailsView.Clear()
+			case 'm':
+				populateMigrationsList()
+				pages.SwitchToPage("migrations")
+				detailsView.Clear()
+			case 't':
+				snapshotsVolume = nil
+				populateSnapshotsList()
+				pages.SwitchToPage("snapshots")
+				detailsView.Clear()
+			case 'b':
+				populateBackupsList()
+				pages.SwitchToPage("backups")
+				detailsView.Clear()
+			case 'y':
+				populateVolumeTypesList()
+				pages.SwitchToPage("volumetypes")
+				detailsView.Clear()
+			case 'e':
+				populateTransfersList()
+				pages.SwitchToPage("transfers")
+				detailsView.Clear()
+			case 'o':
+				populateBlockStorageList()
+				pages.SwitchToPage("blockstorage")
+				detailsView.Clear()
 			case 'p':
 				pages.SwitchToPage("projects")
 				detailsView.Clear()
@@ -107,6 +344,7 @@ func main() {
 			default:
 				return event
 			}
+			leaveProgress()
 		}
 
 		return event
@@ -131,7 +369,7 @@ func main() {
 				header.Clear()
 				_, _, width, _ := header.GetInnerRect()
 
-				shortcuts := "(a)ggregates (p)rojects (d)ns (i)mages (f)lavors (h)ypervisors (l)oadbalancers (s)ervers (n)etworks (v)olumes (q)uit"
+				shortcuts := "(a)ggregates (p)rojects (d)ns (i)mages (f)lavors (h)ypervisors (l)oadbalancers (s)ervers (n)etworks (v)olumes (k)eypairs server(g)roups (u)sage (c)ompute services availability (z)ones placement (r)esource providers (m)igrations snapsho(t)s (b)ackups volume t(y)pes volume transf(e)rs bl(o)ck storage (q)uit"
 				text := fmt.Sprintf("%s%*s", shortcuts, width-len(shortcuts), now)
 				fmt.Fprintf(header, "%s", text)
 				fmt.Fprintf(header, "\n")
@@ -145,23 +383,490 @@ func main() {
 	detailsView = tview.NewTextView()
 	detailsView.SetBorder(true).SetTitle(" Details ").SetTitleAlign(tview.AlignCenter)
 
+	progressView = tview.NewTextView()
+	progressView.SetDynamicColors(true).SetBorder(true).SetTitle(" Progress ").SetTitleAlign(tview.AlignCenter)
+	progressView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			pages.SwitchToPage(progressReturnPage)
+			leaveProgress()
+			return nil
+		}
+		return event
+	})
+
 	aggregatesList = tview.NewList()
 	aggregatesList.SetBorder(true).SetTitle(" Aggregates ").SetTitleAlign(tview.AlignCenter)
+	aggregatesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Rune() == 'N' {
+			showCreateAggregateForm()
+			return nil
+		}
+		index := aggregatesList.GetCurrentItem()
+		if index < 0 || index >= len(aggregateItems) {
+			return event
+		}
+		aggregate := aggregateItems[index]
+		switch event.Rune() {
+		case 'D':
+			confirmAction(fmt.Sprintf("Delete aggregate %s?", aggregate.Name), func() {
+				err := aggregates.DeleteAggregate(aggregate.ID)
+				populateAggregatesList()
+				reportAction(fmt.Sprintf("Deletion of aggregate %s", aggregate.Name), err)
+			})
+			return nil
+		case 'Z':
+			form := tview.NewForm()
+			form.AddInputField("Availability zone", aggregate.AvailabilityZone, 40, nil, nil)
+			form.AddButton("Save", func() {
+				zone := form.GetFormItemByLabel("Availability zone").(*tview.InputField).GetText()
+				closeModal("form")
+				err := aggregates.SetAggregateAvailabilityZone(aggregate.ID, zone)
+				populateAggregatesList()
+				aggregatesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Availability zone change of %s", aggregate.Name), err)
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Availability zone of %s", aggregate.Name), form, 7)
+			return nil
+		case 'H':
+			showAggregateHostForm(aggregate, index, true)
+			return nil
+		case 'R':
+			showAggregateHostForm(aggregate, index, false)
+			return nil
+		case 'M':
+			form := tview.NewForm()
+			form.AddInputField("Key", "", 40, nil, nil)
+			form.AddInputField("Value", "", 40, nil, nil)
+			form.AddButton("Save", func() {
+				key := form.GetFormItemByLabel("Key").(*tview.InputField).GetText()
+				value := form.GetFormItemByLabel("Value").(*tview.InputField).GetText()
+				closeModal("form")
+				err := aggregates.SetAggregateMetadatum(aggregate.ID, key, value)
+				populateAggregatesList()
+				aggregatesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Update of %s on %s", key, aggregate.Name), err)
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Set metadata on %s", aggregate.Name), form, 9)
+			return nil
+		case 'X':
+			if len(aggregate.Metadata) == 0 {
+				return nil
+			}
+			var keys []string
+			for key := range aggregate.Metadata {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			form := tview.NewForm()
+			form.AddDropDown("Key", keys, 0, nil)
+			form.AddButton("Delete", func() {
+				_, key := form.GetFormItemByLabel("Key").(*tview.DropDown).GetCurrentOption()
+				closeModal("form")
+				err := aggregates.DeleteAggregateMetadatum(aggregate.ID, key)
+				populateAggregatesList()
+				aggregatesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Deletion of %s on %s", key, aggregate.Name), err)
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Delete metadata from %s", aggregate.Name), form, 7)
+			return nil
+		}
+		return event
+	})
 
 	serverList = tview.NewList()
 	serverList.SetBorder(true).SetTitle(" Servers ").SetTitleAlign(tview.AlignCenter)
+	serverList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Key() == tcell.KeyEscape && hypervisorServersParentOpts != nil {
+			leaveHypervisorServers()
+			pages.SwitchToPage("hypervisors")
+			detailsView.Clear()
+			return nil
+		}
+		switch event.Rune() {
+		case 'F':
+			showServerTagFilterForm()
+			return nil
+		case 'G':
+			serverListOpts.AllTenants = !serverListOpts.AllTenants
+			populateServersList()
+			detailsView.Clear()
+			return nil
+		}
+		server := selectedServer()
+		if server == nil {
+			return event
+		}
+		switch event.Rune() {
+		case 'A':
+			populateServerActionsList(server.Server)
+			pages.SwitchToPage("serveractions")
+			detailsView.Clear()
+			return nil
+		case 'R':
+			if server.Status == "VERIFY_RESIZE" {
+				showVerifyResizeModal(server.Server)
+			} else {
+				showResizeForm(server.Server)
+			}
+			return nil
+		case 'M':
+			showColdMigrateForm(server.Server)
+			return nil
+		case 'L':
+			showLiveMigrateForm(server.Server)
+			return nil
+		case 'P':
+			watchServerProgress(server.ID)
+			return nil
+		case 'B':
+			showRebuildForm(server.Server)
+			return nil
+		case 'E':
+			if server.Status == "RESCUE" {
+				confirmAction(fmt.Sprintf("Exit rescue mode for server %s?", server.Name), func() {
+					err := servers.UnrescueServer(server.ID)
+					if reportAction(fmt.Sprintf("Unrescue of %s", server.Name), err) {
+						watchServerProgress(server.ID)
+					}
+				})
+			} else {
+				showRescueForm(server.Server)
+			}
+			return nil
+		case 'S':
+			showSnapshotForm(server.Server)
+			return nil
+		case 'I':
+			attachmentsServer = server.Server
+			populateServerAttachmentsList()
+			pages.SwitchToPage("serverattachments")
+			detailsView.Clear()
+			return nil
+		case 'D':
+			watchServerDiagnostics(server.Server)
+			return nil
+		case 'T':
+			metadataServer = server.Server
+			populateServerMetadataList()
+			pages.SwitchToPage("servermetadata")
+			detailsView.Clear()
+			return nil
+		}
+		return event
+	})
+
+	serverActionsList = tview.NewList()
+	serverActionsList.SetBorder(true).SetTitle(" Instance Actions ").SetTitleAlign(tview.AlignCenter)
+	serverActionsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			pages.SwitchToPage("servers")
+			detailsView.Clear()
+			return nil
+		}
+		return event
+	})
+
+	serverMetadataList = tview.NewList()
+	serverMetadataList.SetBorder(true).SetTitle(" Metadata & Tags ").SetTitleAlign(tview.AlignCenter)
+	serverMetadataList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Key() == tcell.KeyEscape {
+			pages.SwitchToPage("servers")
+			detailsView.Clear()
+			return nil
+		}
+		switch event.Rune() {
+		case 'N':
+			showServerMetadataForm(nil)
+			return nil
+		}
+		index := serverMetadataList.GetCurrentItem()
+		if index < 0 || index >= len(serverMetadataItems) {
+			return event
+		}
+		entry := serverMetadataItems[index]
+		switch event.Rune() {
+		case 'E':
+			if !entry.tag {
+				showServerMetadataForm(&entry)
+			}
+			return nil
+		case 'D':
+			confirmAction(fmt.Sprintf("Delete %s from server %s?", entry.key, metadataServer.Name), func() {
+				var err error
+				if entry.tag {
+					err = servers.DeleteServerTag(metadataServer.ID, entry.key)
+				} else {
+					err = servers.DeleteServerMetadatum(metadataServer.ID, entry.key)
+				}
+				populateServerMetadataList()
+				reportAction(fmt.Sprintf("Deletion of %s", entry.key), err)
+			})
+			return nil
+		}
+		return event
+	})
+
+	serverAttachmentsList = tview.NewList()
+	serverAttachmentsList.SetBorder(true).SetTitle(" Interfaces & Volumes ").SetTitleAlign(tview.AlignCenter)
+	serverAttachmentsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Key() == tcell.KeyEscape {
+			// Refresh so the server details pick up the new attached volumes.
+			index := serverList.GetCurrentItem()
+			populateServersList()
+			serverList.SetCurrentItem(index)
+			pages.SwitchToPage("servers")
+			detailsView.Clear()
+			return nil
+		}
+		switch event.Rune() {
+		case 'N':
+			showAttachInterfaceForm()
+			return nil
+		case 'V':
+			showAttachVolumeForm()
+			return nil
+		}
+		index := serverAttachmentsList.GetCurrentItem()
+		if index < 0 || index >= len(serverAttachmentItems) {
+			return event
+		}
+		entry := serverAttachmentItems[index]
+		switch event.Rune() {
+		case 'D':
+			confirmAction(fmt.Sprintf("Detach %s from server %s?", entry.name, attachmentsServer.Name), func() {
+				serverID := attachmentsServer.ID
+				if entry.volume {
+					err := servers.DetachServerVolume(serverID, entry.id)
+					if reportAction(fmt.Sprintf("Detach of %s", entry.name), err) {
+						refreshServerAttachmentsWhen(serverID, volumeSettled(entry.id))
+					}
+					return
+				}
+				err := servers.DetachServerInterface(serverID, entry.id)
+				if reportAction(fmt.Sprintf("Detach of %s", entry.name), err) {
+					refreshServerAttachmentsWhen(serverID, func() bool {
+						for _, iface := range servers.FetchServerInterfaces(serverID) {
+							if iface.PortID == entry.id {
+								return false
+							}
+						}
+						return true
+					})
+				}
+			})
+			return nil
+		}
+		return event
+	})
 
 	imagesList = tview.NewList()
 	imagesList.SetBorder(true).SetTitle(" Images ").SetTitleAlign(tview.AlignCenter)
+	imagesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		index := imagesList.GetCurrentItem()
+		if index < 0 || index >= len(imageItems) {
+			return event
+		}
+		switch event.Rune() {
+		case 'P':
+			watchImageProgress(imageItems[index].ID)
+			return nil
+		}
+		return event
+	})
 
 	flavorsList = tview.NewList()
 	flavorsList.SetBorder(true).SetTitle(" Flavors ").SetTitleAlign(tview.AlignCenter)
+	flavorsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		index := flavorsList.GetCurrentItem()
+		if index < 0 || index >= len(flavorItems) {
+			return event
+		}
+		switch event.Rune() {
+		case 'C':
+			showCapacityPlanForm(flavorItems[index])
+			return nil
+		}
+		return event
+	})
+
+	hypervisorSummaryView = tview.NewTextView()
+	hypervisorSummaryView.SetDynamicColors(true).SetBorder(true).SetTitle(" Cloud Capacity ").SetTitleAlign(tview.AlignCenter)
 
 	hypervisorsList = tview.NewList()
 	hypervisorsList.SetBorder(true).SetTitle(" Hypervisors ").SetTitleAlign(tview.AlignCenter)
+	hypervisorsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		index := hypervisorsList.GetCurrentItem()
+		if index < 0 || index >= len(hypervisorItems) {
+			return event
+		}
+		hypervisor := hypervisorItems[index]
+		switch event.Rune() {
+		case 'S':
+			showHypervisorServers(hypervisor)
+			return nil
+		case 'M':
+			showMaintenanceForm(hypervisor)
+			return nil
+		case 'E':
+			confirmAction(fmt.Sprintf("Enable the nova-compute service on %s?", hypervisor.Service.Host), func() {
+				computeServices := services.FetchServices("nova-compute", hypervisor.Service.Host)
+				var err error
+				if len(computeServices) == 0 {
+					err = fmt.Errorf("no nova-compute service found on %s", hypervisor.Service.Host)
+				}
+				for _, service := range computeServices {
+					err = errors.Join(err, services.EnableService(service.ID))
+				}
+				reportAction(fmt.Sprintf("Enabling nova-compute on %s", hypervisor.Service.Host), err)
+			})
+			return nil
+		}
+		return event
+	})
 
 	volumesList = tview.NewList()
 	volumesList.SetBorder(true).SetTitle(" Volumes ").SetTitleAlign(tview.AlignCenter)
+	volumesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'N':
+			showCreateVolumeForm()
+			return nil
+		case 'G':
+			volumeListOpts.AllTenants = !volumeListOpts.AllTenants
+			populateVolumesList()
+			detailsView.Clear()
+			return nil
+		case 'F':
+			showVolumeOrphanFilterForm()
+			return nil
+		}
+		index := volumesList.GetCurrentItem()
+		if index < 0 || index >= len(volumeItems) {
+			return event
+		}
+		volume := volumeItems[index]
+		name := volumeName(volume)
+		switch event.Rune() {
+		case 'E':
+			form := tview.NewForm()
+			form.AddInputField("New size (GB)", strconv.Itoa(volume.Size+1), 10, tview.InputFieldInteger, nil)
+			form.AddButton("Extend", func() {
+				size, _ := strconv.Atoi(form.GetFormItemByLabel("New size (GB)").(*tview.InputField).GetText())
+				closeModal("form")
+				if reportAction(fmt.Sprintf("Extension of %s to %d GB", name, size), volumes.ExtendVolume(volume.ID, size)) {
+					watchVolumeProgress(volume.ID)
+				}
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Extend %s (%d GB)", name, volume.Size), form, 7)
+			return nil
+		case 'T':
+			showRetypeVolumeForm(volume)
+			return nil
+		case 'B':
+			bootable := volume.Bootable != "true"
+			confirmAction(fmt.Sprintf("Set bootable to %t on %s?", bootable, name), func() {
+				err := volumes.SetVolumeBootable(volume.ID, bootable)
+				populateVolumesList()
+				volumesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Bootable change of %s", name), err)
+			})
+			return nil
+		case 'O':
+			readOnly := volume.Metadata["readonly"] != "True"
+			confirmAction(fmt.Sprintf("Set read-only to %t on %s?", readOnly, name), func() {
+				err := volumes.SetVolumeReadOnly(volume.ID, readOnly)
+				populateVolumesList()
+				volumesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Read-only change of %s", name), err)
+			})
+			return nil
+		case 'S':
+			statuses := []string{"available", "in-use", "error", "creating", "attaching", "detaching", "deleting", "error_deleting", "maintenance"}
+			current := -1
+			for i, status := range statuses {
+				if status == volume.Status {
+					current = i
+				}
+			}
+			if current == -1 {
+				statuses = append(statuses, volume.Status)
+				current = len(statuses) - 1
+			}
+			form := tview.NewForm()
+			form.AddDropDown("Status", statuses, current, nil)
+			form.AddButton("Reset", func() {
+				_, status := form.GetFormItemByLabel("Status").(*tview.DropDown).GetCurrentOption()
+				closeModal("form")
+				confirmAction(fmt.Sprintf("Reset state of %s from %s to %s?", name, volume.Status, status), func() {
+					err := volumes.ResetVolumeStatus(volume.ID, status)
+					populateVolumesList()
+					volumesList.SetCurrentItem(index)
+					reportAction(fmt.Sprintf("Status reset of %s to %s", name, status), err)
+				})
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Reset state of %s (%s)", name, volume.Status), form, 7)
+			return nil
+		case 'D':
+			confirmAction(fmt.Sprintf("Delete volume %s?", name), func() {
+				err := volumes.DeleteVolume(volume.ID)
+				populateVolumesList()
+				reportAction(fmt.Sprintf("Deletion of volume %s", name), err)
+			})
+			return nil
+		case 'P':
+			watchVolumeProgress(volume.ID)
+			return nil
+		case 'X':
+			showCreateTransferForm(volume)
+			return nil
+		case 'L':
+			snapshotsVolume = &volume
+			populateSnapshotsList()
+			pages.SwitchToPage("snapshots")
+			detailsView.Clear()
+			return nil
+		}
+		return event
+	})
 
 	loadbalancersList = tview.NewList()
 	loadbalancersList.SetBorder(true).SetTitle(" Loadbalancers ").SetTitleAlign(tview.AlignCenter)
@@ -172,40 +877,381 @@ func main() {
 	networksList = tview.NewList()
 	networksList.SetBorder(true).SetTitle(" Networks ").SetTitleAlign(tview.AlignCenter)
 
-	projectsList = tview.NewList()
-	projectsList.SetBorder(true).SetTitle(" Projects ").SetTitleAlign(tview.AlignCenter)
-	for _, project := range projects.FetchProjects() {
-		projectsList.AddItem(project.Name, "", -1, func() {
+	keypairsList = tview.NewList()
+	keypairsList.SetBorder(true).SetTitle(" Keypairs ").SetTitleAlign(tview.AlignCenter)
+	keypairsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'N':
+			showImportKeypairForm()
+			return nil
+		case 'G':
+			showGenerateKeypairForm()
+			return nil
+		case 'D':
+			if keypairsList.GetItemCount() == 0 {
+				return nil
+			}
+			name, _ := keypairsList.GetItemText(keypairsList.GetCurrentItem())
+			confirmAction(fmt.Sprintf("Delete keypair %s?", name), func() {
+				err := keypairs.DeleteKeypair(name)
+				populateKeypairsList()
+				reportAction(fmt.Sprintf("Deletion of keypair %s", name), err)
+			})
+			return nil
+		}
+		return event
+	})
+
+	serverGroupsList = tview.NewList()
+	serverGroupsList.SetBorder(true).SetTitle(" Server Groups ").SetTitleAlign(tview.AlignCenter)
+	serverGroupsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'G':
+			serverGroupsAllProjects = !serverGroupsAllProjects
+			populateServerGroupsList()
 			detailsView.Clear()
-			os.Setenv("OS_PROJECT_NAME", project.Name)
-			fmt.Fprintf(detailsView, "Current Project Set To:\nID: %s\nName: %s\nDescription: %s\nDomainID: %s\nEnabled: %t", project.ID, project.Name, project.Description, project.DomainID, project.Enabled)
-		})
-	}
+			return nil
+		}
+		return event
+	})
 
-	// This is our handy dandy input prompt
-	inputPrompt = tview.NewInputField()
-	inputPrompt.SetLabel("Command: ").
-		SetFieldWidth(30).
-		SetBorder(true).
-		SetTitle(" Command Prompt ").
-		SetTitleAlign(tview.AlignLeft)
+	usageList = tview.NewList()
+	usageList.SetBorder(true).SetTitle(" Usage ").SetTitleAlign(tview.AlignCenter)
+	usageList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'W':
+			showUsageWindowForm()
+			return nil
+		}
+		return event
+	})
 
-	inputPrompt.SetDoneFunc(func(key tcell.Key) {
-		if key == tcell.KeyEnter {
-			command := inputPrompt.GetText()
-			inputPrompt.SetText("")
-			if command == "aggregates" {
-				populateAggregatesList()
-				pages.SwitchToPage(command)
-				detailsView.Clear()
+	servicesList = tview.NewList()
+	servicesList.SetBorder(true).SetTitle(" Compute Services ").SetTitleAlign(tview.AlignCenter)
+	servicesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		index := servicesList.GetCurrentItem()
+		if index < 0 || index >= len(serviceItems) {
+			return event
+		}
+		service := serviceItems[index]
+		name := fmt.Sprintf("%s on %s", service.Binary, service.Host)
+		switch event.Rune() {
+		case 'E':
+			confirmAction(fmt.Sprintf("Enable %s?", name), func() {
+				err := services.EnableService(service.ID)
+				populateServicesList()
+				servicesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Enabling %s", name), err)
+			})
+			return nil
+		case 'D':
+			form := tview.NewForm()
+			form.AddInputField("Reason", "", 40, nil, nil)
+			form.AddButton("Disable", func() {
+				reason := form.GetFormItemByLabel("Reason").(*tview.InputField).GetText()
+				closeModal("form")
+				err := services.DisableService(service.ID, reason)
+				populateServicesList()
+				servicesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Disabling %s", name), err)
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Disable %s", name), form, 7)
+			return nil
+		case 'F':
+			question := fmt.Sprintf("Mark %s as forced down?", name)
+			if service.ForcedDown {
+				question = fmt.Sprintf("Clear forced down on %s?", name)
 			}
+			confirmAction(question, func() {
+				err := services.SetServiceForcedDown(service.ID, !service.ForcedDown)
+				populateServicesList()
+				servicesList.SetCurrentItem(index)
+				reportAction(fmt.Sprintf("Forced down change of %s", name), err)
+			})
+			return nil
+		}
+		return event
+	})
 
-			if command == "images" {
-				populateImagesList()
-				pages.SwitchToPage(command)
-				detailsView.Clear()
+	zonesList = tview.NewList()
+	zonesList.SetBorder(true).SetTitle(" Availability Zones ").SetTitleAlign(tview.AlignCenter)
+	zonesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		index := zonesList.GetCurrentItem()
+		if index < 0 || index >= len(zoneItems) {
+			return event
+		}
+		entry := zoneItems[index]
+		switch event.Rune() {
+		case 'H':
+			if !entry.compute || entry.host == "" {
+				return nil
+			}
+			populateHypervisorsList()
+			pages.SwitchToPage("hypervisors")
+			detailsView.Clear()
+			for i, hypervisor := range hypervisorItems {
+				if hypervisor.Service.Host == entry.host {
+					hypervisorsList.SetCurrentItem(i)
+					break
+				}
+			}
+			return nil
+		case 'A':
+			if !entry.compute {
+				return nil
+			}
+			populateAggregatesList()
+			pages.SwitchToPage("aggregates")
+			detailsView.Clear()
+			for i, aggregate := range aggregateItems {
+				if aggregate.AvailabilityZone == entry.zone {
+					aggregatesList.SetCurrentItem(i)
+					break
+				}
+			}
+			return nil
+		}
+		return event
+	})
+
+	resourceProvidersList = tview.NewList()
+	resourceProvidersList.SetBorder(true).SetTitle(" Resource Providers ").SetTitleAlign(tview.AlignCenter)
+	resourceProvidersList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'F':
+			showAllocationsLookupForm()
+			return nil
+		}
+		return event
+	})
+
+	migrationsList = tview.NewList()
+	migrationsList.SetBorder(true).SetTitle(" Migrations ").SetTitleAlign(tview.AlignCenter)
+	migrationsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'F':
+			showMigrationsFilterForm()
+			return nil
+		case 'M':
+			loadMoreMigrations()
+			return nil
+		}
+		return event
+	})
+
+	snapshotsList = tview.NewList()
+	snapshotsList.SetBorder(true).SetTitle(" Snapshots ").SetTitleAlign(tview.AlignCenter)
+	snapshotsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Key() == tcell.KeyEscape && snapshotsVolume != nil {
+			snapshotsVolume = nil
+			pages.SwitchToPage("volumes")
+			detailsView.Clear()
+			return nil
+		}
+		if event.Rune() == 'N' {
+			showCreateSnapshotForm()
+			return nil
+		}
+		index := snapshotsList.GetCurrentItem()
+		if index < 0 || index >= len(snapshotItems) {
+			return event
+		}
+		snapshot := snapshotItems[index]
+		name := snapshot.Name
+		if name == "" {
+			name = snapshot.ID
+		}
+		switch event.Rune() {
+		case 'D':
+			confirmAction(fmt.Sprintf("Delete snapshot %s?", name), func() {
+				err := snapshots.DeleteSnapshot(snapshot.ID)
+				populateSnapshotsList()
+				reportAction(fmt.Sprintf("Deletion of snapshot %s", name), err)
+			})
+			return nil
+		case 'V':
+			form := tview.NewForm()
+			form.AddInputField("Name", "", 40, nil, nil)
+			form.AddInputField("Size (GB)", strconv.Itoa(snapshot.Size), 10, tview.InputFieldInteger, nil)
+			form.AddButton("Create", func() {
+				volumeName := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+				size, _ := strconv.Atoi(form.GetFormItemByLabel("Size (GB)").(*tview.InputField).GetText())
+				closeModal("form")
+				volumeID, err := volumes.CreateVolume(volumeName, size, "", "", "", snapshot.ID)
+				if reportAction(fmt.Sprintf("Creation of volume %s from snapshot %s", volumeName, name), err) {
+					populateVolumesList()
+					pages.SwitchToPage("volumes")
+					watchVolumeProgress(volumeID)
+				}
+			})
+			form.AddButton("Cancel", func() {
+				closeModal("form")
+			})
+			showForm(fmt.Sprintf("Create volume from %s", name), form, 9)
+			return nil
+		case 'P':
+			watchSnapshotProgress(snapshot.ID)
+			return nil
+		}
+		return event
+	})
+
+	backupsList = tview.NewList()
+	backupsList.SetBorder(true).SetTitle(" Backups ").SetTitleAlign(tview.AlignCenter)
+	backupsList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Rune() == 'N' {
+			showCreateBackupForm()
+			return nil
+		}
+		index := backupsList.GetCurrentItem()
+		if index < 0 || index >= len(backupItems) {
+			return event
+		}
+		backup := backupItems[index]
+		name := backup.Name
+		if name == "" {
+			name = backup.ID
+		}
+		switch event.Rune() {
+		case 'R':
+			showRestoreBackupForm(backup)
+			return nil
+		case 'D':
+			confirmAction(fmt.Sprintf("Delete backup %s?", name), func() {
+				err := backups.DeleteBackup(backup.ID)
+				populateBackupsList()
+				reportAction(fmt.Sprintf("Deletion of backup %s", name), err)
+			})
+			return nil
+		case 'P':
+			watchBackupProgress(backup.ID, "")
+			return nil
+		}
+		return event
+	})
+
+	volumeTypesList = tview.NewList()
+	volumeTypesList.SetBorder(true).SetTitle(" Volume Types and QoS Specs ").SetTitleAlign(tview.AlignCenter)
+	volumeTypesList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		switch event.Rune() {
+		case 'N':
+			showVolumeTypeForm(nil)
+			return nil
+		case 'C':
+			showCreateQoSSpecForm()
+			return nil
+		}
+		index := volumeTypesList.GetCurrentItem()
+		if index >= 0 && index < len(volumeTypeItems) {
+			if handleVolumeTypeKey(event.Rune(), volumeTypeItems[index], index) {
+				return nil
+			}
+		} else if index >= len(volumeTypeItems) && index-len(volumeTypeItems) < len(qosItems) {
+			if handleQoSSpecKey(event.Rune(), qosItems[index-len(volumeTypeItems)], index) {
+				return nil
+			}
+		}
+		return event
+	})
+
+	transfersList = tview.NewList()
+	transfersList.SetBorder(true).SetTitle(" Volume Transfers ").SetTitleAlign(tview.AlignCenter)
+	transfersList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if !acceptShortcuts {
+			return event
+		}
+		if event.Rune() == 'A' {
+			showAcceptTransferForm()
+			return nil
+		}
+		index := transfersList.GetCurrentItem()
+		if index < 0 || index >= len(transferItems) {
+			return event
+		}
+		transfer := transferItems[index]
+		switch event.Rune() {
+		case 'D':
+			confirmAction(fmt.Sprintf("Cancel transfer %s?", transfer.Name), func() {
+				err := transfers.DeleteTransfer(transfer.ID)
+				populateTransfersList()
+				reportAction(fmt.Sprintf("Deletion of transfer %s", transfer.Name), err)
+			})
+			return nil
+		}
+		return event
+	})
+
+	blockStorageList = tview.NewList()
+	blockStorageList.SetBorder(true).SetTitle(" Block Storage ").SetTitleAlign(tview.AlignCenter)
+
+	projectsList = tview.NewList()
+	projectsList.SetBorder(true).SetTitle(" Projects ").SetTitleAlign(tview.AlignCenter)
+	for _, project := range projects.FetchProjects() {
+		projectsList.AddItem(project.Name, "", -1, func() {
+			detailsView.Clear()
+			os.Setenv("OS_PROJECT_NAME", project.Name)
+			fmt.Fprintf(detailsView, "Current Project Set To:\nID: %s\nName: %s\nDescription: %s\nDomainID: %s\nEnabled: %t", project.ID, project.Name, project.Description, project.DomainID, project.Enabled)
+		})
+	}
+
+	// This is our handy dandy input prompt
+	inputPrompt = tview.NewInputField()
+	inputPrompt.SetLabel("Command: ").
+		SetFieldWidth(30).
+		SetBorder(true).
+		SetTitle(" Command Prompt ").
+		SetTitleAlign(tview.AlignLeft)
+
+	inputPrompt.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			command := inputPrompt.GetText()
+			inputPrompt.SetText("")
+			if command == "aggregates" {
+				populateAggregatesList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "images" {
+				populateImagesList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
 			}
 			if command == "servers" {
+				leaveHypervisorServers()
 				populateServersList()
 				pages.SwitchToPage(command)
 				detailsView.Clear()
@@ -241,12 +1287,82 @@ func main() {
 				pages.SwitchToPage(command)
 				detailsView.Clear()
 			}
+			if command == "keypairs" {
+				populateKeypairsList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+			if command == "servergroups" {
+				populateServerGroupsList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+			if command == "usage" {
+				populateUsageList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+			if command == "services" {
+				populateServicesList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "zones" {
+				populateZonesList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "placement" {
+				populateResourceProvidersList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "migrations" {
+				populateMigrationsList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "snapshots" {
+				snapshotsVolume = nil
+				populateSnapshotsList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "backups" {
+				populateBackupsList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "volumetypes" {
+				populateVolumeTypesList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "transfers" {
+				populateTransfersList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
+
+			if command == "blockstorage" {
+				populateBlockStorageList()
+				pages.SwitchToPage(command)
+				detailsView.Clear()
+			}
 
 			if command == "projects" {
 				pages.SwitchToPage(command)
 				detailsView.Clear()
 			}
 
+			leaveProgress()
 			headerFlex.ResizeItem(inputPrompt, 0, 0) // hide prompt
 			acceptShortcuts = true
 		}
@@ -279,6 +1395,31 @@ func main() {
 			AddItem(detailsView, 0, 4, false),
 			0, 5, true)
 
+	serverActionsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(serverActionsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	serverMetadataViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(serverMetadataList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	serverAttachmentsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(serverAttachmentsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	progressViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(progressView, 0, 5, true)
+
 	imageViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(headerFlex, 0, 1, false).
 		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
@@ -295,6 +1436,7 @@ func main() {
 
 	hypervisorsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(headerFlex, 0, 1, false).
+		AddItem(hypervisorSummaryView, 5, 0, false).
 		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
 			AddItem(hypervisorsList, 0, 1, true).
 			AddItem(detailsView, 0, 4, false),
@@ -328,6 +1470,90 @@ func main() {
 			AddItem(detailsView, 0, 4, false),
 			0, 5, true)
 
+	keypairsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(keypairsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	serverGroupsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(serverGroupsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	usageViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(usageList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	servicesViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(servicesList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	zonesViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(zonesList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	placementViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(resourceProvidersList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	migrationsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(migrationsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	snapshotsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(snapshotsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	backupsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(backupsList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	volumeTypesViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(volumeTypesList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	transfersViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(transfersList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
+	blockStorageViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(headerFlex, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
+			AddItem(blockStorageList, 0, 1, true).
+			AddItem(detailsView, 0, 4, false),
+			0, 5, true)
+
 	projectsViewFlex := tview.NewFlex().SetDirection(tview.FlexRow).
 		AddItem(headerFlex, 0, 1, false).
 		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
@@ -340,6 +1566,10 @@ func main() {
 	pages.AddPage("prompt", inputPrompt, true, false)
 	pages.AddPage("aggregates", aggregateViewFlex, true, true)
 	pages.AddPage("servers", serverViewFlex, true, true)
+	pages.AddPage("serveractions", serverActionsViewFlex, true, true)
+	pages.AddPage("servermetadata", serverMetadataViewFlex, true, true)
+	pages.AddPage("serverattachments", serverAttachmentsViewFlex, true, true)
+	pages.AddPage("progress", progressViewFlex, true, true)
 	pages.AddPage("images", imageViewFlex, true, true)
 	pages.AddPage("flavors", flavorViewFlex, true, true)
 	pages.AddPage("hypervisors", hypervisorsViewFlex, true, true)
@@ -347,6 +1577,18 @@ func main() {
 	pages.AddPage("loadbalancers", loadbalancerViewFlex, true, true)
 	pages.AddPage("dns", dnsViewFlex, true, true)
 	pages.AddPage("networks", networksViewFlex, true, true)
+	pages.AddPage("keypairs", keypairsViewFlex, true, true)
+	pages.AddPage("servergroups", serverGroupsViewFlex, true, true)
+	pages.AddPage("usage", usageViewFlex, true, true)
+	pages.AddPage("services", servicesViewFlex, true, true)
+	pages.AddPage("zones", zonesViewFlex, true, true)
+	pages.AddPage("placement", placementViewFlex, true, true)
+	pages.AddPage("migrations", migrationsViewFlex, true, true)
+	pages.AddPage("snapshots", snapshotsViewFlex, true, true)
+	pages.AddPage("backups", backupsViewFlex, true, true)
+	pages.AddPage("volumetypes", volumeTypesViewFlex, true, true)
+	pages.AddPage("transfers", transfersViewFlex, true, true)
+	pages.AddPage("blockstorage", blockStorageViewFlex, true, true)
 	pages.AddPage("projects", projectsViewFlex, true, true)
 
 	err := app.SetRoot(pages, true).Run()
@@ -355,70 +1597,1957 @@ func main() {
 	}
 }
 
-func populateServersList() {
-	serverList.Clear()
-	for _, server := range servers.FetchServers() {
-		serverList.AddItem(server.Name, "", -1, func() {
-			detailsView.Clear()
-			flavorID, _ := server.Flavor["id"].(string)
-			flavor := flavors.FetchFlavorByID(flavorID)
-			flavorInfo := fmt.Sprintf("\n\tName: %s, \n\tRAM: %dMB, \n\tvCPUs: %d, \n\tDisk: %dGB", flavor.Name, flavor.RAM, flavor.VCPUs, flavor.Disk)
+// showModal overlays a centered primitive on top of the current page. Global
+// shortcuts are disabled until closeModal is called so typing is not hijacked.
+func showModal(name string, primitive tview.Primitive, width, height int) {
+	acceptShortcuts = false
+	modal := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(primitive, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+	pages.AddPage(name, modal, true, true)
+	app.SetFocus(primitive)
+}
 
-			imageID, _ := server.Image["id"].(string)
-			image := images.FetchImageByID(imageID)
-			imageInfo := fmt.Sprintf("\n\tName: %s,\n\tID: %s, \n\tSize: %dMB, \n\tTags: %s", image.Name, imageID, image.SizeBytes, image.Tags)
+func closeModal(name string) {
+	pages.RemovePage(name)
+	acceptShortcuts = true
+}
 
-			addresses, err := json.Marshal(server.Addresses)
-			if err != nil {
-				addresses = []byte("unable to marshal addresses")
+// showForm displays a form as a modal. Pressing Escape closes it.
+func showForm(title string, form *tview.Form, height int) {
+	form.SetBorder(true).SetTitle(fmt.Sprintf(" %s ", title)).SetTitleAlign(tview.AlignCenter)
+	form.SetCancelFunc(func() {
+		closeModal("form")
+	})
+	showModal("form", form, 70, height)
+}
+
+// confirmAction asks for a yes/no confirmation before running onConfirm.
+func confirmAction(text string, onConfirm func()) {
+	acceptShortcuts = false
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"Yes", "No"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			closeModal("confirm")
+			if buttonLabel == "Yes" {
+				onConfirm()
 			}
-			fmt.Fprintf(detailsView, "ID: %s\nStatus: %s\nFlavor: %s\nImage: %s\nNetworks: %s\nAttached Volumes: %s", server.ID, server.Status, flavorInfo, imageInfo, addresses, server.AttachedVolumes)
 		})
+	pages.AddPage("confirm", modal, false, true)
+	app.SetFocus(modal)
+}
+
+// reportAction writes the outcome of an action to the details pane and
+// returns true when it succeeded.
+func reportAction(description string, err error) bool {
+	detailsView.Clear()
+	if err != nil {
+		fmt.Fprintf(detailsView, "%s failed:\n\t%s", description, err)
+		return false
 	}
+	fmt.Fprintf(detailsView, "%s requested", description)
+	return true
 }
 
-func populateAggregatesList() {
-	aggregatesList.Clear()
-	for _, aggregate := range aggregates.FetchAggregates() {
-		var hosts string
-		for _, host := range aggregate.Hosts {
-			hosts += fmt.Sprintf("\n\t%s", host)
+// watchProgress switches to the progress page and redraws it with render every
+// few seconds until render reports done or the user leaves with Escape.
+// leaveProgress stops the progress watch once another page has replaced the
+// progress page, so its poller does not keep running in the background.
+func leaveProgress() {
+	if name, _ := pages.GetFrontPage(); name == "progress" || stopProgressWatch == nil {
+		return
+	}
+	close(stopProgressWatch)
+	stopProgressWatch = nil
+}
+
+func watchProgress(title string, render func() (text string, done bool)) {
+	if stopProgressWatch != nil {
+		close(stopProgressWatch)
+	}
+	stop := make(chan struct{})
+	stopProgressWatch = stop
+
+	if name, _ := pages.GetFrontPage(); name != "progress" {
+		progressReturnPage = name
+	}
+
+	progressView.Clear()
+	progressView.SetTitle(fmt.Sprintf(" %s ", title))
+	pages.SwitchToPage("progress")
+
+	go func() {
+		for {
+			text, done := render()
+			app.QueueUpdateDraw(func() {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				progressView.Clear()
+				fmt.Fprintf(progressView, "%s\n\nLast refreshed: %s\n(Esc) back", text, time.Now().Format("15:04:05"))
+			})
+			if done {
+				return
+			}
+
+			select {
+			case <-stop:
+				return
+			case <-time.After(3 * time.Second):
+			}
+		}
+	}()
+}
+
+func populateServersList() {
+	serverList.Clear()
+	title := " Servers "
+	if serverListOpts.AllTenants {
+		title = " Servers (all projects) "
+	}
+	if serverListOpts.Host != "" {
+		title += fmt.Sprintf("(host: %s) ", serverListOpts.Host)
+	}
+	switch {
+	case serverListOpts.Tags != "":
+		title += fmt.Sprintf("(tags: %s) ", serverListOpts.Tags)
+	case serverListOpts.TagsAny != "":
+		title += fmt.Sprintf("(any tag: %s) ", serverListOpts.TagsAny)
+	}
+	serverList.SetTitle(title)
+	serverItems = servers.FetchServers(serverListOpts)
+	for _, server := range serverItems {
+		var columns string
+		if serverListOpts.AllTenants {
+			flavorID, _ := server.Flavor["id"].(string)
+			columns = fmt.Sprintf("%-20s %-16s %-12s %s", projects.FetchProjectName(server.TenantID), flavors.FetchFlavorName(flavorID), server.Status, server.Host)
+		}
+		serverList.AddItem(server.Name, columns, -1, func() {
+			detailsView.Clear()
+			flavorID, _ := server.Flavor["id"].(string)
+			flavor := flavors.FetchFlavorByID(flavorID)
+			flavorInfo := fmt.Sprintf("\n\tName: %s, \n\tRAM: %dMB, \n\tvCPUs: %d, \n\tDisk: %dGB", flavor.Name, flavor.RAM, flavor.VCPUs, flavor.Disk)
+
+			imageID, _ := server.Image["id"].(string)
+			image := images.FetchImageByID(imageID)
+			imageInfo := fmt.Sprintf("\n\tName: %s,\n\tID: %s, \n\tSize: %dMB, \n\tTags: %s", image.Name, imageID, image.SizeBytes, image.Tags)
+
+			addresses, err := json.Marshal(server.Addresses)
+			if err != nil {
+				addresses = []byte("unable to marshal addresses")
+			}
+			fmt.Fprintf(detailsView, "ID: %s\nStatus: %s\nProject: %s (%s)\nHost: %s\nFlavor: %s\nImage: %s\nNetworks: %s\nAttached Volumes: %s", server.ID, server.Status, projects.FetchProjectName(server.TenantID), server.TenantID, server.Host, flavorInfo, imageInfo, addresses, server.AttachedVolumes)
+			if server.Fault.Code != 0 || server.Fault.Message != "" {
+				fmt.Fprintf(detailsView, "\nFault:\n\tCode: %d\n\tMessage: %s\n\tCreated: %s\n\tDetails: %s", server.Fault.Code, server.Fault.Message, server.Fault.Created, server.Fault.Details)
+			}
+			if server.Status == "VERIFY_RESIZE" {
+				fmt.Fprintf(detailsView, "\n\nResize/migration awaiting confirmation: press R to confirm or revert")
+			}
+			if server.Status == "RESCUE" {
+				fmt.Fprintf(detailsView, "\n\nServer is in rescue mode: press E to exit rescue")
+			}
+			fmt.Fprintf(detailsView, "\n\n(A)ctions history, (R)esize, cold (M)igrate, (L)ive migrate, (P)rogress, re(B)uild, r(E)scue, (S)napshot, (T)ags & metadata, (F)ilter by tag, (I)nterfaces & volumes, (G)lobal all-projects toggle, (D)iagnostics")
+		})
+	}
+}
+
+// selectedServer returns the server currently highlighted in serverList.
+func selectedServer() *servers.ServerWithExt {
+	index := serverList.GetCurrentItem()
+	if index < 0 || index >= len(serverItems) {
+		return nil
+	}
+	return &serverItems[index]
+}
+
+func showResizeForm(server openstack_servers.Server) {
+	flavorList := flavors.FetchFlavors()
+	var flavorNames []string
+	for _, flavor := range flavorList {
+		flavorNames = append(flavorNames, fmt.Sprintf("%s (%d vCPU, %dMB RAM, %dGB disk)", flavor.Name, flavor.VCPUs, flavor.RAM, flavor.Disk))
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Flavor", flavorNames, 0, nil)
+	form.AddButton("Resize", func() {
+		index, _ := form.GetFormItemByLabel("Flavor").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		if index < 0 {
+			return
+		}
+		flavor := flavorList[index]
+		err := servers.ResizeServer(server.ID, flavor.ID)
+		if reportAction(fmt.Sprintf("Resize of %s to %s", server.Name, flavor.Name), err) {
+			watchServerProgress(server.ID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Resize %s", server.Name), form, 9)
+}
+
+// showVerifyResizeModal lets the user confirm or revert a server waiting in VERIFY_RESIZE.
+func showVerifyResizeModal(server openstack_servers.Server) {
+	acceptShortcuts = false
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Server %s is waiting in VERIFY_RESIZE.\nConfirm the resize/migration or revert it?", server.Name)).
+		AddButtons([]string{"Confirm", "Revert", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			closeModal("confirm")
+			switch buttonLabel {
+			case "Confirm":
+				err := servers.ConfirmResizeServer(server.ID)
+				if reportAction(fmt.Sprintf("Resize confirmation of %s", server.Name), err) {
+					watchServerProgress(server.ID)
+				}
+			case "Revert":
+				err := servers.RevertResizeServer(server.ID)
+				if reportAction(fmt.Sprintf("Resize revert of %s", server.Name), err) {
+					watchServerProgress(server.ID)
+				}
+			}
+		})
+	pages.AddPage("confirm", modal, false, true)
+	app.SetFocus(modal)
+}
+
+func showColdMigrateForm(server openstack_servers.Server) {
+	hosts := []string{"(scheduler selected)"}
+	for _, hypervisor := range hypervisors.FetchHypervisors() {
+		hosts = append(hosts, hypervisor.Service.Host)
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Destination host", hosts, 0, nil)
+	form.AddButton("Migrate", func() {
+		index, _ := form.GetFormItemByLabel("Destination host").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		var host string
+		if index > 0 {
+			host = hosts[index]
+		}
+		err := servers.MigrateServer(server.ID, host)
+		if reportAction(fmt.Sprintf("Cold migration of %s", server.Name), err) {
+			watchServerProgress(server.ID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Cold migrate %s", server.Name), form, 9)
+}
+
+func showLiveMigrateForm(server openstack_servers.Server) {
+	hosts := []string{"(scheduler selected)"}
+	for _, hypervisor := range hypervisors.FetchHypervisors() {
+		hosts = append(hosts, hypervisor.Service.Host)
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Destination host", hosts, 0, nil)
+	form.AddCheckbox("Block migration", false, nil)
+	form.AddButton("Migrate", func() {
+		index, _ := form.GetFormItemByLabel("Destination host").(*tview.DropDown).GetCurrentOption()
+		blockMigration := form.GetFormItemByLabel("Block migration").(*tview.Checkbox).IsChecked()
+		closeModal("form")
+		var host string
+		if index > 0 {
+			host = hosts[index]
+		}
+		err := servers.LiveMigrateServer(server.ID, host, blockMigration)
+		if reportAction(fmt.Sprintf("Live migration of %s", server.Name), err) {
+			watchServerProgress(server.ID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Live migrate %s", server.Name), form, 11)
+}
+
+func showRebuildForm(server openstack_servers.Server) {
+	imageList := images.FetchImages()
+	var imageNames []string
+	for _, image := range imageList {
+		imageNames = append(imageNames, image.Name)
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Image", imageNames, 0, nil)
+	form.AddButton("Rebuild", func() {
+		index, _ := form.GetFormItemByLabel("Image").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		if index < 0 {
+			return
+		}
+		image := imageList[index]
+		confirmAction(fmt.Sprintf("Rebuild server %s from image %s?\nAll data on the root disk will be lost.", server.Name, image.Name), func() {
+			err := servers.RebuildServer(server.ID, image.ID)
+			if reportAction(fmt.Sprintf("Rebuild of %s from %s", server.Name, image.Name), err) {
+				watchServerProgress(server.ID)
+			}
+		})
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Rebuild %s", server.Name), form, 9)
+}
+
+func showRescueForm(server openstack_servers.Server) {
+	imageList := images.FetchImages()
+	imageNames := []string{"(server image)"}
+	for _, image := range imageList {
+		imageNames = append(imageNames, image.Name)
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Rescue image", imageNames, 0, nil)
+	form.AddButton("Rescue", func() {
+		index, _ := form.GetFormItemByLabel("Rescue image").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		var imageID string
+		if index > 0 {
+			imageID = imageList[index-1].ID
+		}
+		adminPass, err := servers.RescueServer(server.ID, imageID)
+		if reportAction(fmt.Sprintf("Rescue of %s", server.Name), err) {
+			fmt.Fprintf(detailsView, "\nRescue admin password: %s", adminPass)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Rescue %s", server.Name), form, 9)
+}
+
+func showSnapshotForm(server openstack_servers.Server) {
+	form := tview.NewForm()
+	form.AddInputField("Snapshot name", fmt.Sprintf("%s-snapshot-%s", server.Name, time.Now().Format("20060102-1504")), 40, nil, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Snapshot name").(*tview.InputField).GetText()
+		closeModal("form")
+		imageID, err := servers.CreateServerSnapshot(server.ID, name)
+		if !reportAction(fmt.Sprintf("Snapshot of %s", server.Name), err) {
+			return
+		}
+		populateImagesList()
+		pages.SwitchToPage("images")
+		watchImageProgress(imageID)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Snapshot %s", server.Name), form, 9)
+}
+
+// watchServerProgress follows a server through a resize or migration, showing
+// its state and, for live migrations, the memory and disk copy progress.
+func watchServerProgress(serverID string) {
+	watchProgress("Server Progress", func() (string, bool) {
+		server := servers.FetchServer(serverID)
+		if server == nil {
+			return fmt.Sprintf("Unable to fetch server %s", serverID), true
+		}
+
+		text := fmt.Sprintf("Server: %s (%s)\nStatus: %s\nTask State: %s\nVM State: %s\nHost: %s", server.Name, server.ID, server.Status, server.TaskState, server.VmState, server.Host)
+		for _, migration := range servers.FetchServerMigrations(serverID) {
+			text += fmt.Sprintf("\n\nMigration %d: %s\n\t%s -> %s\n\tMemory: %s\n\tDisk: %s", migration.ID, migration.Status, migration.SourceCompute, migration.DestCompute,
+				formatProgress(migration.MemoryProcessedBytes, migration.MemoryTotalBytes), formatProgress(migration.DiskProcessedBytes, migration.DiskTotalBytes))
+		}
+
+		switch server.Status {
+		case "VERIFY_RESIZE":
+			text += "\n\n[yellow]Waiting for confirmation: go back and press R on the server to confirm or revert.[-]"
+			return text, true
+		case "ERROR":
+			text += fmt.Sprintf("\n\n[red]Server went to ERROR: %s[-]", server.Fault.Message)
+			return text, true
+		}
+		return text, server.TaskState == ""
+	})
+}
+
+// watchServerDiagnostics shows the diagnostics counters of a server, refreshing them periodically.
+func watchServerDiagnostics(server openstack_servers.Server) {
+	watchProgress(fmt.Sprintf("Diagnostics: %s", server.Name), func() (string, bool) {
+		diagnostics := servers.FetchServerDiagnostics(server.ID)
+		if diagnostics == nil {
+			return fmt.Sprintf("Unable to fetch diagnostics for %s (admin only)", server.Name), true
+		}
+
+		text := fmt.Sprintf("State: %s\nDriver: %s\nHypervisor: %s (%s)\nUptime: %s\nMemory: %d/%d MB used",
+			diagnostics.State, diagnostics.Driver, diagnostics.Hypervisor, diagnostics.HypervisorOS,
+			time.Duration(diagnostics.Uptime)*time.Second, diagnostics.MemoryDetails.Used, diagnostics.MemoryDetails.Maximum)
+
+		text += fmt.Sprintf("\n\nCPUs: %d", diagnostics.NumCPUs)
+		for _, cpu := range diagnostics.CPUDetails {
+			utilisation := "n/a"
+			if cpu.Utilisation != nil {
+				utilisation = fmt.Sprintf("%d%%", *cpu.Utilisation)
+			}
+			text += fmt.Sprintf("\n\tCPU %d: time %s, utilisation %s", cpu.ID, time.Duration(cpu.Time), utilisation)
+		}
+
+		text += fmt.Sprintf("\n\nDisks: %d", diagnostics.NumDisks)
+		for index, disk := range diagnostics.DiskDetails {
+			text += fmt.Sprintf("\n\tDisk %d: read %d MB (%d requests), written %d MB (%d requests), errors %d", index,
+				disk.ReadBytes/1024/1024, disk.ReadRequests, disk.WriteBytes/1024/1024, disk.WriteRequests, disk.ErrorsCount)
+		}
+
+		text += fmt.Sprintf("\n\nNICs: %d", diagnostics.NumNICs)
+		for _, nic := range diagnostics.NICDetails {
+			text += fmt.Sprintf("\n\t%s: rx %d MB (%d packets, %d errors, %d dropped), tx %d MB (%d packets, %d errors, %d dropped)", nic.MacAddress,
+				nic.RxOctets/1024/1024, nic.RxPackets, nic.RxErrors, nic.RxDrop, nic.TxOctets/1024/1024, nic.TxPackets, nic.TxErrors, nic.TxDrop)
+		}
+
+		return text, false
+	})
+}
+
+// formatProgress renders processed/total bytes as a percentage.
+func formatProgress(processed, total int64) string {
+	if total == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d/%d MB (%d%%)", processed/1024/1024, total/1024/1024, processed*100/total)
+}
+
+func showServerTagFilterForm() {
+	tagFilter := serverListOpts.Tags
+	matchIndex := 0
+	if serverListOpts.TagsAny != "" {
+		tagFilter = serverListOpts.TagsAny
+		matchIndex = 1
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Tags (comma separated)", tagFilter, 40, nil, nil)
+	form.AddDropDown("Match", []string{"all tags", "any tag"}, matchIndex, nil)
+	form.AddButton("Filter", func() {
+		tagFilter := strings.ReplaceAll(form.GetFormItemByLabel("Tags (comma separated)").(*tview.InputField).GetText(), " ", "")
+		matchIndex, _ := form.GetFormItemByLabel("Match").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		serverListOpts.Tags = ""
+		serverListOpts.TagsAny = ""
+		if matchIndex == 1 {
+			serverListOpts.TagsAny = tagFilter
+		} else {
+			serverListOpts.Tags = tagFilter
+		}
+		populateServersList()
+		detailsView.Clear()
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Filter servers by tag", form, 11)
+}
+
+func populateServerMetadataList() {
+	serverMetadataList.Clear()
+	serverMetadataList.SetTitle(fmt.Sprintf(" Metadata & Tags: %s ", metadataServer.Name))
+	serverMetadataItems = nil
+
+	metadata := servers.FetchServerMetadata(metadataServer.ID)
+	var keys []string
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		serverMetadataItems = append(serverMetadataItems, serverMetadataEntry{key: key, value: metadata[key]})
+	}
+	for _, tag := range servers.FetchServerTags(metadataServer.ID) {
+		serverMetadataItems = append(serverMetadataItems, serverMetadataEntry{tag: true, key: tag})
+	}
+
+	for _, entry := range serverMetadataItems {
+		if entry.tag {
+			serverMetadataList.AddItem(fmt.Sprintf("tag: %s", entry.key), "", -1, func() {
+				detailsView.Clear()
+				fmt.Fprintf(detailsView, "Tag: %s\n\n(N)ew, (D)elete", entry.key)
+			})
+			continue
+		}
+		serverMetadataList.AddItem(fmt.Sprintf("%s = %s", entry.key, entry.value), "", -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Key: %s\nValue: %s\n\n(N)ew, (E)dit, (D)elete", entry.key, entry.value)
+		})
+	}
+}
+
+// showServerMetadataForm adds a metadata key or tag, or edits the value of entry when it is set.
+func showServerMetadataForm(entry *serverMetadataEntry) {
+	form := tview.NewForm()
+	title := fmt.Sprintf("Add metadata or tag to %s", metadataServer.Name)
+	if entry == nil {
+		form.AddDropDown("Type", []string{"metadata", "tag"}, 0, nil)
+		form.AddInputField("Key / tag", "", 40, nil, nil)
+		form.AddInputField("Value", "", 40, nil, nil)
+	} else {
+		title = fmt.Sprintf("Edit %s on %s", entry.key, metadataServer.Name)
+		form.AddInputField("Value", entry.value, 40, nil, nil)
+	}
+	form.AddButton("Save", func() {
+		value := form.GetFormItemByLabel("Value").(*tview.InputField).GetText()
+		var err error
+		var key string
+		if entry == nil {
+			typeIndex, _ := form.GetFormItemByLabel("Type").(*tview.DropDown).GetCurrentOption()
+			key = form.GetFormItemByLabel("Key / tag").(*tview.InputField).GetText()
+			if typeIndex == 1 {
+				err = servers.AddServerTag(metadataServer.ID, key)
+			} else {
+				err = servers.SetServerMetadatum(metadataServer.ID, key, value)
+			}
+		} else {
+			key = entry.key
+			err = servers.SetServerMetadatum(metadataServer.ID, key, value)
+		}
+		closeModal("form")
+		populateServerMetadataList()
+		reportAction(fmt.Sprintf("Update of %s", key), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(title, form, 13)
+}
+
+func populateServerAttachmentsList() {
+	serverAttachmentsList.Clear()
+	serverAttachmentsList.SetTitle(fmt.Sprintf(" Interfaces & Volumes: %s ", attachmentsServer.Name))
+	serverAttachmentItems = nil
+
+	for _, iface := range servers.FetchServerInterfaces(attachmentsServer.ID) {
+		var fixedIPs string
+		for _, fixedIP := range iface.FixedIPs {
+			fixedIPs += fmt.Sprintf("\n\t\t%s (subnet %s)", fixedIP.IPAddress, fixedIP.SubnetID)
+		}
+		entry := serverAttachmentEntry{id: iface.PortID, name: fmt.Sprintf("port %s", iface.PortID)}
+		serverAttachmentItems = append(serverAttachmentItems, entry)
+		serverAttachmentsList.AddItem(entry.name, "", -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Port ID: %s\nNetwork ID: %s\nMAC Address: %s\nState: %s\nFixed IPs: %s\n\n(D)etach", iface.PortID, iface.NetID, iface.MACAddr, iface.PortState, fixedIPs)
+		})
+	}
+
+	for _, attachment := range servers.FetchServerVolumeAttachments(attachmentsServer.ID) {
+		volumeName := attachment.VolumeID
+		if volume := volumes.FetchVolumeByID(attachment.VolumeID); volume != nil && volume.Name != "" {
+			volumeName = volume.Name
+		}
+		entry := serverAttachmentEntry{volume: true, id: attachment.VolumeID, name: fmt.Sprintf("volume %s", volumeName)}
+		serverAttachmentItems = append(serverAttachmentItems, entry)
+		serverAttachmentsList.AddItem(entry.name, attachment.Device, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Volume: %s\nVolume ID: %s\nDevice: %s\nAttachment ID: %s\n\n(D)etach", volumeName, attachment.VolumeID, attachment.Device, attachment.ID)
+		})
+	}
+}
+
+// refreshServerAttachmentsWhen polls settled in the background until the
+// asynchronous attach or detach has finished, then refreshes the interfaces and
+// volumes of serverID if they are still shown.
+func refreshServerAttachmentsWhen(serverID string, settled func() bool) {
+	go func() {
+		for i := 0; i < 30 && !settled(); i++ {
+			time.Sleep(2 * time.Second)
+		}
+		app.QueueUpdateDraw(func() {
+			if attachmentsServer.ID == serverID {
+				populateServerAttachmentsList()
+			}
+		})
+	}()
+}
+
+// volumeSettled reports whether a volume has finished attaching or detaching.
+func volumeSettled(volumeID string) func() bool {
+	return func() bool {
+		volume := volumes.FetchVolumeByID(volumeID)
+		if volume == nil {
+			return true
+		}
+		switch volume.Status {
+		case "attaching", "detaching", "reserved":
+			return false
+		}
+		return true
+	}
+}
+
+// showAttachInterfaceForm attaches an available port, or a new port on a network, to attachmentsServer.
+func showAttachInterfaceForm() {
+	// The server may belong to another project when listing all projects.
+	portList := networks.FetchAvailablePorts(attachmentsServer.TenantID)
+	networkList := networks.FetchAttachableNetworks(attachmentsServer.TenantID)
+
+	var options []string
+	for _, port := range portList {
+		options = append(options, fmt.Sprintf("port: %s (%s)", port.Name, port.ID))
+	}
+	for _, network := range networkList {
+		options = append(options, fmt.Sprintf("network: %s (new port)", network.Name))
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Port or network", options, 0, nil)
+	form.AddButton("Attach", func() {
+		index, _ := form.GetFormItemByLabel("Port or network").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		if index < 0 {
+			return
+		}
+		var portID, networkID string
+		if index < len(portList) {
+			portID = portList[index].ID
+		} else {
+			networkID = networkList[index-len(portList)].ID
+		}
+		interfaceCount := len(servers.FetchServerInterfaces(attachmentsServer.ID))
+		err := servers.AttachServerInterface(attachmentsServer.ID, portID, networkID)
+		if reportAction(fmt.Sprintf("Interface attach to %s", attachmentsServer.Name), err) {
+			serverID := attachmentsServer.ID
+			refreshServerAttachmentsWhen(serverID, func() bool {
+				return len(servers.FetchServerInterfaces(serverID)) != interfaceCount
+			})
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Attach interface to %s", attachmentsServer.Name), form, 9)
+}
+
+// showAttachVolumeForm attaches an available volume to attachmentsServer.
+func showAttachVolumeForm() {
+	// The server may belong to another project when listing all projects.
+	var projectID string
+	if serverListOpts.AllTenants {
+		projectID = attachmentsServer.TenantID
+	}
+	volumeList := volumes.FetchAvailableVolumes(projectID)
+	var volumeNames []string
+	for _, volume := range volumeList {
+		volumeNames = append(volumeNames, fmt.Sprintf("%s (%dGB, %s)", volume.Name, volume.Size, volume.ID))
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Volume", volumeNames, 0, nil)
+	form.AddButton("Attach", func() {
+		index, _ := form.GetFormItemByLabel("Volume").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		if index < 0 {
+			return
+		}
+		volume := volumeList[index]
+		err := servers.AttachServerVolume(attachmentsServer.ID, volume.ID)
+		if reportAction(fmt.Sprintf("Attach of volume %s to %s", volume.Name, attachmentsServer.Name), err) {
+			refreshServerAttachmentsWhen(attachmentsServer.ID, volumeSettled(volume.ID))
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Attach volume to %s", attachmentsServer.Name), form, 9)
+}
+
+func populateServerActionsList(server openstack_servers.Server) {
+	serverActionsList.Clear()
+	serverActionsList.SetTitle(fmt.Sprintf(" Instance Actions: %s ", server.Name))
+	for _, action := range servers.FetchInstanceActions(server.ID) {
+		serverActionsList.AddItem(action.Action, action.StartTime.Format("2006-01-02 15:04:05"), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Action: %s\nRequest ID: %s\nUser ID: %s\nProject ID: %s\nStart Time: %s\nMessage: %s", action.Action, action.RequestID, action.UserID, action.ProjectID, action.StartTime, action.Message)
+
+			detail := servers.FetchInstanceActionByRequestID(server.ID, action.RequestID)
+			if detail == nil || detail.Events == nil {
+				return
+			}
+			var events string
+			for _, event := range *detail.Events {
+				var host string
+				if event.Host != nil {
+					host = *event.Host
+				}
+				events += fmt.Sprintf("\n\tEvent: %s\n\t\tResult: %s\n\t\tHost: %s\n\t\tStart Time: %s\n\t\tFinish Time: %s", event.Event, event.Result, host, event.StartTime, event.FinishTime)
+				if event.Traceback != "" {
+					events += fmt.Sprintf("\n\t\tTraceback: %s", event.Traceback)
+				}
+			}
+			fmt.Fprintf(detailsView, "\nEvents: %s", events)
+		})
+	}
+}
+
+func populateAggregatesList() {
+	aggregatesList.Clear()
+	aggregateItems = aggregates.FetchAggregates()
+	for _, aggregate := range aggregateItems {
+		var hosts string
+		for _, host := range aggregate.Hosts {
+			hosts += fmt.Sprintf("\n\t%s", host)
+		}
+		var keys []string
+		for key := range aggregate.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var metadata string
+		for _, key := range keys {
+			metadata += fmt.Sprintf("\n\t%s = %s", key, aggregate.Metadata[key])
+		}
+		secondary := fmt.Sprintf("%d hosts", len(aggregate.Hosts))
+		if aggregate.AvailabilityZone != "" {
+			secondary = fmt.Sprintf("%s, %s", aggregate.AvailabilityZone, secondary)
+		}
+		aggregatesList.AddItem(aggregate.Name, secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %d\nName: %s\nAvailability Zone: %s\nMetadata: %s\nHosts: %s\n\n(N)ew, (D)elete, availability (Z)one, add (H)ost, (R)emove host, set (M)etadata, delete metadata (X)",
+				aggregate.ID, aggregate.Name, aggregate.AvailabilityZone, metadata, hosts)
+		})
+	}
+}
+
+func showCreateAggregateForm() {
+	form := tview.NewForm()
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddInputField("Availability zone", "", 40, nil, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		zone := form.GetFormItemByLabel("Availability zone").(*tview.InputField).GetText()
+		closeModal("form")
+		err := aggregates.CreateAggregate(name, zone)
+		populateAggregatesList()
+		reportAction(fmt.Sprintf("Creation of aggregate %s", name), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Create aggregate", form, 9)
+}
+
+// showAggregateHostForm offers the compute hosts not yet in the aggregate when
+// adding, or the aggregate's own hosts when removing.
+func showAggregateHostForm(aggregate openstack_aggregates.Aggregate, index int, add bool) {
+	var hosts []string
+	if add {
+		member := map[string]bool{}
+		for _, host := range aggregate.Hosts {
+			member[host] = true
+		}
+		for _, service := range services.FetchServices("nova-compute", "") {
+			if !member[service.Host] {
+				hosts = append(hosts, service.Host)
+			}
+		}
+		sort.Strings(hosts)
+	} else {
+		hosts = aggregate.Hosts
+	}
+	if len(hosts) == 0 {
+		detailsView.Clear()
+		fmt.Fprintf(detailsView, "No compute hosts available for %s", aggregate.Name)
+		return
+	}
+
+	title, button := fmt.Sprintf("Add host to %s", aggregate.Name), "Add"
+	if !add {
+		title, button = fmt.Sprintf("Remove host from %s", aggregate.Name), "Remove"
+	}
+	form := tview.NewForm()
+	form.AddDropDown("Host", hosts, 0, nil)
+	form.AddButton(button, func() {
+		_, host := form.GetFormItemByLabel("Host").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		var err error
+		description := fmt.Sprintf("Adding %s to %s", host, aggregate.Name)
+		if add {
+			err = aggregates.AddAggregateHost(aggregate.ID, host)
+		} else {
+			description = fmt.Sprintf("Removing %s from %s", host, aggregate.Name)
+			err = aggregates.RemoveAggregateHost(aggregate.ID, host)
+		}
+		populateAggregatesList()
+		aggregatesList.SetCurrentItem(index)
+		reportAction(description, err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(title, form, 7)
+}
+
+func populateFlavorsList() {
+	flavorsList.Clear()
+	flavorItems = flavors.FetchFlavors()
+	for _, flavor := range flavorItems {
+		flavorsList.AddItem(flavor.Name, "", -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nVCPU: %d\nRAM: %d\nDisk: %d\n\n(C)apacity: where would this flavor fit?", flavor.ID, flavor.Name, flavor.VCPUs, flavor.RAM, flavor.Disk)
+		})
+	}
+}
+
+// showCapacityPlanForm asks how many instances of flavor to plan for and
+// optionally limits the calculation to the hosts of an aggregate or zone.
+func showCapacityPlanForm(flavor openstack_flavors.Flavor) {
+	scopes := []string{"All hypervisors"}
+	scopeHosts := []map[string]bool{nil}
+	for _, aggregate := range aggregates.FetchAggregates() {
+		hosts := map[string]bool{}
+		for _, host := range aggregate.Hosts {
+			hosts[host] = true
+		}
+		scopes = append(scopes, fmt.Sprintf("Aggregate %s", aggregate.Name))
+		scopeHosts = append(scopeHosts, hosts)
+	}
+	for _, zone := range availabilityzones.FetchComputeAvailabilityZones() {
+		hosts := map[string]bool{}
+		for host := range zone.Hosts {
+			hosts[host] = true
+		}
+		scopes = append(scopes, fmt.Sprintf("Zone %s", zone.ZoneName))
+		scopeHosts = append(scopeHosts, hosts)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Count", "1", 10, tview.InputFieldInteger, nil)
+	form.AddDropDown("Limit to", scopes, 0, nil)
+	form.AddButton("Calculate", func() {
+		count, err := strconv.Atoi(form.GetFormItemByLabel("Count").(*tview.InputField).GetText())
+		scopeIndex, _ := form.GetFormItemByLabel("Limit to").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		if err != nil || count < 1 {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Capacity planning for %s needs a count of at least 1", flavor.Name)
+			return
+		}
+		showCapacityPlan(flavor, count, scopes[scopeIndex], scopeHosts[scopeIndex])
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Capacity for %s", flavor.Name), form, 9)
+}
+
+// showCapacityPlan works out how many instances of flavor fit on each enabled
+// and up hypervisor in hosts (all of them when nil), using placement inventories
+// so that reserved resources and allocation ratios are taken into account.
+func showCapacityPlan(flavor openstack_flavors.Flavor, count int, scope string, hosts map[string]bool) {
+	// Placement claims swap as part of DISK_GB, rounded up to whole GB.
+	resources := map[string]int{
+		"VCPU":      flavor.VCPUs,
+		"MEMORY_MB": flavor.RAM,
+		"DISK_GB":   flavor.Disk + flavor.Ephemeral + (flavor.Swap+1023)/1024,
+	}
+
+	detailsView.Clear()
+	fmt.Fprintf(detailsView, "Flavor: %s (%d vCPU, %d MB RAM, %d GB disk)\nScope: %s\n",
+		flavor.Name, resources["VCPU"], resources["MEMORY_MB"], resources["DISK_GB"], scope)
+
+	capacities := placement.FetchResourceProviderCapacities()
+	if capacities == nil {
+		fmt.Fprintf(detailsView, "\nFailed to load placement inventories")
+		return
+	}
+
+	total := 0
+	for _, hypervisor := range hypervisors.FetchHypervisors() {
+		if hosts != nil && !hosts[hypervisor.Service.Host] {
+			continue
+		}
+		if hypervisor.Status != "enabled" || hypervisor.State != "up" {
+			fmt.Fprintf(detailsView, "\n\t%s: skipped (%s, %s)", hypervisor.HypervisorHostname, hypervisor.Status, hypervisor.State)
+			continue
+		}
+		capacity, ok := capacities[hypervisor.HypervisorHostname]
+		if !ok {
+			fmt.Fprintf(detailsView, "\n\t%s: no resource provider", hypervisor.HypervisorHostname)
+			continue
+		}
+		fits, limitedBy := capacity.Fits(resources)
+		total += fits
+		fmt.Fprintf(detailsView, "\n\t%s: %d (limited by %s)", hypervisor.HypervisorHostname, fits, limitedBy)
+	}
+
+	fmt.Fprintf(detailsView, "\n\nTotal: %d instances fit", total)
+	if total >= count {
+		fmt.Fprintf(detailsView, "\nRequested %d: fits, with room for %d more", count, total-count)
+	} else {
+		fmt.Fprintf(detailsView, "\nRequested %d: does not fit, short by %d", count, count-total)
+	}
+}
+
+func populateVolumesList() {
+	volumesList.Clear()
+	volumeItems = nil
+	var orphanGB int
+	cutoff := time.Now().AddDate(0, 0, -volumeOrphanDays)
+	for _, volume := range volumes.FetchVolumes(volumeListOpts) {
+		if volumeOrphanDays > 0 {
+			if volume.Status != "available" || len(volume.Attachments) > 0 || volume.CreatedAt.After(cutoff) {
+				continue
+			}
+			orphanGB += volume.Size
+		}
+		volumeItems = append(volumeItems, volume)
+	}
+
+	title := " Volumes "
+	if volumeListOpts.AllTenants {
+		title = " Volumes (all projects) "
+	}
+	if volumeOrphanDays > 0 {
+		title = fmt.Sprintf("%s- unattached for %d+ days: %d volumes, %d GB ", title, volumeOrphanDays, len(volumeItems), orphanGB)
+	}
+	volumesList.SetTitle(title)
+
+	for _, volume := range volumeItems {
+		secondary := fmt.Sprintf("%d GB, %s", volume.Size, volume.Status)
+		if volumeOrphanDays > 0 {
+			secondary += fmt.Sprintf(", created %s", volume.CreatedAt.Format(time.DateOnly))
+		}
+		volumesList.AddItem(volume.Name, secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "\n\tID: %s\n\tName: %s\n\tDescription: %s\n\tCreated at: %s\n\tUpdated at: %s\n\tSize: %d\n\tType: %s\n\tStatus: %s\n\tAvailability Zone: %s\n\tBootable: %s\n\tRead-only: %t",
+				volume.ID, volume.Name, volume.Description, volume.CreatedAt, volume.UpdatedAt, volume.Size, volume.VolumeType, volume.Status, volume.AvailabilityZone, volume.Bootable, volume.Metadata["readonly"] == "True")
+
+			fmt.Fprintf(detailsView, "\n\tAttachments:")
+			if len(volume.Attachments) == 0 {
+				fmt.Fprintf(detailsView, " none")
+			}
+			for _, attachment := range volume.Attachments {
+				serverName, host := attachment.ServerID, attachment.HostName
+				if server := servers.FetchServer(attachment.ServerID); server != nil {
+					serverName = fmt.Sprintf("%s (%s)", server.Name, server.ID)
+					if host == "" {
+						host = server.Host
+					}
+				}
+				fmt.Fprintf(detailsView, "\n\t\tServer: %s\n\t\t\tDevice: %s\n\t\t\tHost: %s\n\t\t\tAttached At: %s",
+					serverName, attachment.Device, host, attachment.AttachedAt)
+			}
+			fmt.Fprintf(detailsView, "\n\n(N)ew, (E)xtend, re(T)ype, (B)ootable toggle, read-(O)nly toggle, reset (S)tate, (D)elete, (P)rogress, (L)ist snapshots, transfer (X), (F)ind orphans, (G) all projects")
+		})
+	}
+}
+
+// showVolumeOrphanFilterForm sets the minimum age of the available, unattached
+// volumes to list; 0 lists every volume again.
+func showVolumeOrphanFilterForm() {
+	form := tview.NewForm()
+	form.AddInputField("Older than (days, 0 for all volumes)", strconv.Itoa(volumeOrphanDays), 10, tview.InputFieldInteger, nil)
+	form.AddButton("Filter", func() {
+		volumeOrphanDays, _ = strconv.Atoi(form.GetFormItemByLabel("Older than (days, 0 for all volumes)").(*tview.InputField).GetText())
+		closeModal("form")
+		populateVolumesList()
+		detailsView.Clear()
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Find orphaned volumes", form, 7)
+}
+
+// volumeName is how a volume is referred to in prompts; volumes often have no name.
+func volumeName(volume openstack_volumes.Volume) string {
+	if volume.Name != "" {
+		return volume.Name
+	}
+	return volume.ID
+}
+
+func showCreateVolumeForm() {
+	typeNames := []string{"(default)"}
+	for _, volumeType := range volumetypes.FetchVolumeTypes() {
+		typeNames = append(typeNames, volumeType.Name)
+	}
+	zoneNames := []string{"(default)"}
+	for _, zone := range availabilityzones.FetchVolumeAvailabilityZones() {
+		zoneNames = append(zoneNames, zone.ZoneName)
+	}
+	// Each source option maps to an image or snapshot ID; the first is an empty volume.
+	sources := []string{"Empty volume"}
+	sourceImages := []string{""}
+	sourceSnapshots := []string{""}
+	for _, image := range images.FetchImages() {
+		sources = append(sources, fmt.Sprintf("Image %s", image.Name))
+		sourceImages = append(sourceImages, image.ID)
+		sourceSnapshots = append(sourceSnapshots, "")
+	}
+	for _, snapshot := range snapshots.FetchSnapshots("") {
+		sources = append(sources, fmt.Sprintf("Snapshot %s (%d GB)", snapshot.Name, snapshot.Size))
+		sourceImages = append(sourceImages, "")
+		sourceSnapshots = append(sourceSnapshots, snapshot.ID)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddInputField("Size (GB)", "1", 10, tview.InputFieldInteger, nil)
+	form.AddDropDown("Type", typeNames, 0, nil)
+	form.AddDropDown("Availability zone", zoneNames, 0, nil)
+	form.AddDropDown("Source", sources, 0, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		size, _ := strconv.Atoi(form.GetFormItemByLabel("Size (GB)").(*tview.InputField).GetText())
+		typeIndex, volumeType := form.GetFormItemByLabel("Type").(*tview.DropDown).GetCurrentOption()
+		if typeIndex == 0 {
+			volumeType = ""
+		}
+		zoneIndex, zone := form.GetFormItemByLabel("Availability zone").(*tview.DropDown).GetCurrentOption()
+		if zoneIndex == 0 {
+			zone = ""
+		}
+		sourceIndex, _ := form.GetFormItemByLabel("Source").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		volumeID, err := volumes.CreateVolume(name, size, volumeType, zone, sourceImages[sourceIndex], sourceSnapshots[sourceIndex])
+		populateVolumesList()
+		if reportAction(fmt.Sprintf("Creation of volume %s", name), err) {
+			watchVolumeProgress(volumeID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Create volume", form, 15)
+}
+
+func showRetypeVolumeForm(volume openstack_volumes.Volume) {
+	var typeNames []string
+	for _, volumeType := range volumetypes.FetchVolumeTypes() {
+		if volumeType.Name != volume.VolumeType {
+			typeNames = append(typeNames, volumeType.Name)
+		}
+	}
+	if len(typeNames) == 0 {
+		detailsView.Clear()
+		fmt.Fprintf(detailsView, "No other volume types available")
+		return
+	}
+	policies := []openstack_volumeactions.MigrationPolicy{openstack_volumeactions.MigrationPolicyNever, openstack_volumeactions.MigrationPolicyOnDemand}
+
+	form := tview.NewForm()
+	form.AddDropDown("New type", typeNames, 0, nil)
+	form.AddDropDown("Migration policy", []string{string(policies[0]), string(policies[1])}, 0, nil)
+	form.AddButton("Retype", func() {
+		_, newType := form.GetFormItemByLabel("New type").(*tview.DropDown).GetCurrentOption()
+		policyIndex, _ := form.GetFormItemByLabel("Migration policy").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		err := volumes.RetypeVolume(volume.ID, newType, policies[policyIndex])
+		if reportAction(fmt.Sprintf("Retype of %s to %s", volumeName(volume), newType), err) {
+			watchVolumeProgress(volume.ID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Retype %s (%s)", volumeName(volume), volume.VolumeType), form, 9)
+}
+
+func populateSnapshotsList() {
+	snapshotsList.Clear()
+	volumeID := ""
+	title := " Snapshots "
+	if snapshotsVolume != nil {
+		volumeID = snapshotsVolume.ID
+		title = fmt.Sprintf(" Snapshots of %s ", volumeName(*snapshotsVolume))
+	}
+	snapshotsList.SetTitle(title)
+
+	volumeNames := map[string]string{}
+	for _, volume := range volumes.FetchVolumes(volumeListOpts) {
+		volumeNames[volume.ID] = volumeName(volume)
+	}
+
+	snapshotItems = snapshots.FetchSnapshots(volumeID)
+	for _, snapshot := range snapshotItems {
+		source := volumeNames[snapshot.VolumeID]
+		if source == "" {
+			source = snapshot.VolumeID
+		}
+		name := snapshot.Name
+		if name == "" {
+			name = snapshot.ID
+		}
+		snapshotsList.AddItem(name, fmt.Sprintf("%s, %d GB, %s, %s", source, snapshot.Size, snapshot.Status, snapshot.CreatedAt.Format(time.DateTime)), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nDescription: %s\nSource Volume: %s (%s)\nSize: %d GB\nStatus: %s\nCreated At: %s\nUpdated At: %s",
+				snapshot.ID, snapshot.Name, snapshot.Description, source, snapshot.VolumeID, snapshot.Size, snapshot.Status, snapshot.CreatedAt, snapshot.UpdatedAt)
+			fmt.Fprintf(detailsView, "\n\n(N)ew snapshot, (D)elete, create (V)olume from snapshot, (P)rogress")
+		})
+	}
+}
+
+// showCreateSnapshotForm snapshots a volume, defaulting to the volume the
+// snapshots list was opened from.
+func showCreateSnapshotForm() {
+	volumeList := volumes.FetchVolumes(volumeListOpts)
+	if len(volumeList) == 0 {
+		detailsView.Clear()
+		fmt.Fprintf(detailsView, "No volumes to snapshot")
+		return
+	}
+	var volumeNames []string
+	selected := 0
+	for i, volume := range volumeList {
+		volumeNames = append(volumeNames, fmt.Sprintf("%s (%s)", volumeName(volume), volume.Status))
+		if snapshotsVolume != nil && volume.ID == snapshotsVolume.ID {
+			selected = i
+		}
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Volume", volumeNames, selected, nil)
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddCheckbox("Force (volume in use)", false, nil)
+	form.AddButton("Create", func() {
+		volumeIndex, _ := form.GetFormItemByLabel("Volume").(*tview.DropDown).GetCurrentOption()
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		force := form.GetFormItemByLabel("Force (volume in use)").(*tview.Checkbox).IsChecked()
+		closeModal("form")
+		snapshotID, err := snapshots.CreateSnapshot(volumeList[volumeIndex].ID, name, force)
+		populateSnapshotsList()
+		if reportAction(fmt.Sprintf("Snapshot %s of %s", name, volumeName(volumeList[volumeIndex])), err) {
+			watchSnapshotProgress(snapshotID)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Create snapshot", form, 11)
+}
+
+// watchSnapshotProgress follows a snapshot until it is available or fails.
+func watchSnapshotProgress(snapshotID string) {
+	watchProgress("Snapshot Progress", func() (string, bool) {
+		snapshot := snapshots.FetchSnapshotByID(snapshotID)
+		if snapshot == nil {
+			return fmt.Sprintf("Unable to fetch snapshot %s", snapshotID), true
+		}
+
+		text := fmt.Sprintf("Snapshot: %s (%s)\nStatus: %s\nSize: %d GB", snapshot.Name, snapshot.ID, snapshot.Status, snapshot.Size)
+		switch snapshot.Status {
+		case "available":
+			return text + "\n\n[green]Snapshot is available.[-]", true
+		case "error", "error_deleting":
+			return text + "\n\n[red]Snapshot failed.[-]", true
+		}
+		return text, false
+	})
+}
+
+func populateBackupsList() {
+	backupsList.Clear()
+	volumeNames := map[string]string{}
+	for _, volume := range volumes.FetchVolumes(volumeListOpts) {
+		volumeNames[volume.ID] = volumeName(volume)
+	}
+
+	backupItems = backups.FetchBackups()
+	for _, backup := range backupItems {
+		source := volumeNames[backup.VolumeID]
+		if source == "" {
+			source = backup.VolumeID
+		}
+		name := backup.Name
+		if name == "" {
+			name = backup.ID
+		}
+		kind := "full"
+		if backup.IsIncremental {
+			kind = "incremental"
+		}
+		backupsList.AddItem(name, fmt.Sprintf("%s, %s, %d GB, %s", source, kind, backup.Size, backup.Status), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nDescription: %s\nVolume: %s (%s)\nSnapshot: %s\nIncremental: %t\nHas Dependent Backups: %t\nSize: %d GB\nObjects: %d\nContainer: %s\nStatus: %s\nFail Reason: %s\nCreated At: %s\nData Timestamp: %s",
+				backup.ID, backup.Name, backup.Description, source, backup.VolumeID, backup.SnapshotID, backup.IsIncremental, backup.HasDependentBackups,
+				backup.Size, backup.ObjectCount, backup.Container, backup.Status, backup.FailReason, backup.CreatedAt, backup.DataTimestamp)
+			fmt.Fprintf(detailsView, "\n\n(N)ew backup, (R)estore, (D)elete, (P)rogress")
+		})
+	}
+}
+
+func showCreateBackupForm() {
+	volumeList := volumes.FetchVolumes(volumeListOpts)
+	if len(volumeList) == 0 {
+		detailsView.Clear()
+		fmt.Fprintf(detailsView, "No volumes to back up")
+		return
+	}
+	var volumeNames []string
+	for _, volume := range volumeList {
+		volumeNames = append(volumeNames, fmt.Sprintf("%s (%s)", volumeName(volume), volume.Status))
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Volume", volumeNames, 0, nil)
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddInputField("Container", "", 40, nil, nil)
+	form.AddCheckbox("Incremental", false, nil)
+	form.AddCheckbox("Force (volume in use)", false, nil)
+	form.AddButton("Create", func() {
+		volumeIndex, _ := form.GetFormItemByLabel("Volume").(*tview.DropDown).GetCurrentOption()
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		container := form.GetFormItemByLabel("Container").(*tview.InputField).GetText()
+		incremental := form.GetFormItemByLabel("Incremental").(*tview.Checkbox).IsChecked()
+		force := form.GetFormItemByLabel("Force (volume in use)").(*tview.Checkbox).IsChecked()
+		closeModal("form")
+		backupID, err := backups.CreateBackup(volumeList[volumeIndex].ID, name, container, incremental, force)
+		populateBackupsList()
+		if reportAction(fmt.Sprintf("Backup %s of %s", name, volumeName(volumeList[volumeIndex])), err) {
+			watchBackupProgress(backupID, "")
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Create backup", form, 15)
+}
+
+// showRestoreBackupForm restores a backup onto a new volume or onto an
+// existing available volume, which is overwritten.
+func showRestoreBackupForm(backup openstack_backups.Backup) {
+	targets := []string{"New volume"}
+	targetIDs := []string{""}
+	for _, volume := range volumes.FetchAvailableVolumes("") {
+		if volume.Size >= backup.Size {
+			targets = append(targets, fmt.Sprintf("%s (%d GB)", volumeName(volume), volume.Size))
+			targetIDs = append(targetIDs, volume.ID)
+		}
+	}
+
+	form := tview.NewForm()
+	form.AddDropDown("Restore to", targets, 0, nil)
+	form.AddInputField("New volume name", "", 40, nil, nil)
+	form.AddButton("Restore", func() {
+		targetIndex, target := form.GetFormItemByLabel("Restore to").(*tview.DropDown).GetCurrentOption()
+		name := form.GetFormItemByLabel("New volume name").(*tview.InputField).GetText()
+		closeModal("form")
+		restore := func() {
+			volumeID, err := backups.RestoreBackup(backup.ID, targetIDs[targetIndex], name)
+			if reportAction(fmt.Sprintf("Restore of backup %s", backup.Name), err) {
+				watchBackupProgress(backup.ID, volumeID)
+			}
+		}
+		if targetIndex == 0 {
+			restore()
+			return
+		}
+		confirmAction(fmt.Sprintf("Overwrite volume %s with backup %s?", target, backup.Name), restore)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Restore backup %s (%d GB)", backup.Name, backup.Size), form, 9)
+}
+
+// watchBackupProgress follows a backup being created, or being restored onto
+// volumeID when it is set, until both settle.
+func watchBackupProgress(backupID, volumeID string) {
+	watchProgress("Backup Progress", func() (string, bool) {
+		backup := backups.FetchBackupByID(backupID)
+		if backup == nil {
+			return fmt.Sprintf("Unable to fetch backup %s", backupID), true
+		}
+
+		text := fmt.Sprintf("Backup: %s (%s)\nStatus: %s\nSize: %d GB\nObjects: %d", backup.Name, backup.ID, backup.Status, backup.Size, backup.ObjectCount)
+		if backup.Status == "error" {
+			return text + fmt.Sprintf("\n\n[red]Backup failed: %s[-]", backup.FailReason), true
+		}
+		if volumeID == "" {
+			if backup.Status == "available" {
+				return text + "\n\n[green]Backup is available.[-]", true
+			}
+			return text, false
+		}
+
+		volume := volumes.FetchVolumeByID(volumeID)
+		if volume == nil {
+			return text + fmt.Sprintf("\n\nUnable to fetch volume %s", volumeID), true
+		}
+		text += fmt.Sprintf("\n\nRestoring to volume: %s (%s)\nVolume Status: %s", volume.Name, volume.ID, volume.Status)
+		switch volume.Status {
+		case "available":
+			if backup.Status == "available" {
+				return text + "\n\n[green]Restore complete.[-]", true
+			}
+		case "error", "error_restoring":
+			return text + "\n\n[red]Restore failed.[-]", true
+		}
+		return text, false
+	})
+}
+
+func populateVolumeTypesList() {
+	volumeTypesList.Clear()
+	qosItems = qos.FetchQoSSpecs()
+	qosNames := map[string]string{}
+	for _, spec := range qosItems {
+		qosNames[spec.ID] = spec.Name
+	}
+
+	volumeTypeItems = volumetypes.FetchAllVolumeTypes()
+	for _, volumeType := range volumeTypeItems {
+		visibility := "public"
+		if !volumeType.IsPublic {
+			visibility = "private"
+		}
+		secondary := visibility
+		if volumeType.QosSpecID != "" {
+			secondary += fmt.Sprintf(", QoS %s", qosNames[volumeType.QosSpecID])
+		}
+		volumeTypesList.AddItem(volumeType.Name, secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Volume Type\nID: %s\nName: %s\nDescription: %s\nVisibility: %s\nQoS Spec: %s\nExtra Specs:%s",
+				volumeType.ID, volumeType.Name, volumeType.Description, visibility, qosNames[volumeType.QosSpecID], formatSpecs(volumeType.ExtraSpecs))
+
+			details := volumetypes.FetchVolumeTypeDetails(volumeType.ID, volumeType.IsPublic)
+			if details != nil {
+				if !volumeType.IsPublic {
+					fmt.Fprintf(detailsView, "\nProject Access:")
+					for _, projectID := range details.ProjectIDs {
+						fmt.Fprintf(detailsView, "\n\t%s (%s)", projects.FetchProjectName(projectID), projectID)
+					}
+				}
+				if details.Encryption == nil {
+					fmt.Fprintf(detailsView, "\nEncryption: none")
+				} else {
+					encryption := details.Encryption
+					fmt.Fprintf(detailsView, "\nEncryption:\n\tProvider: %s\n\tCipher: %s\n\tKey Size: %d\n\tControl Location: %s",
+						encryption.Provider, encryption.Cipher, encryption.KeySize, encryption.ControlLocation)
+				}
+			}
+			fmt.Fprintf(detailsView, "\n\n(N)ew type, (E)dit, (S)et extra spec, (U)nset extra spec, (A)dd or (R)emove project access, (Q)oS association, (D)elete")
+		})
+	}
+
+	for _, spec := range qosItems {
+		volumeTypesList.AddItem(fmt.Sprintf("QoS: %s", spec.Name), spec.Consumer, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "QoS Spec\nID: %s\nName: %s\nConsumer: %s\nSpecs:%s\nAssociated Volume Types:", spec.ID, spec.Name, spec.Consumer, formatSpecs(spec.Specs))
+			for _, association := range qos.FetchQoSAssociations(spec.ID) {
+				fmt.Fprintf(detailsView, "\n\t%s (%s)", association.Name, association.ID)
+			}
+			fmt.Fprintf(detailsView, "\n\n(C)reate QoS spec, (S)et key, (U)nset key, (D)elete")
+		})
+	}
+}
+
+// formatSpecs renders extra specs or QoS specs one key per line, sorted by key.
+func formatSpecs(specs map[string]string) string {
+	var keys []string
+	for key := range specs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var text string
+	for _, key := range keys {
+		text += fmt.Sprintf("\n\t%s = %s", key, specs[key])
+	}
+	return text
+}
+
+// handleVolumeTypeKey runs the volume type action bound to key and reports
+// whether there was one.
+func handleVolumeTypeKey(key rune, volumeType openstack_volumetypes.VolumeType, index int) bool {
+	refresh := func(description string, err error) {
+		populateVolumeTypesList()
+		volumeTypesList.SetCurrentItem(index)
+		reportAction(description, err)
+	}
+
+	switch key {
+	case 'E':
+		showVolumeTypeForm(&volumeType)
+	case 'S':
+		showSpecKeyForm(fmt.Sprintf("Set extra spec on %s", volumeType.Name), func(key, value string) {
+			refresh(fmt.Sprintf("Update of %s on %s", key, volumeType.Name), volumetypes.SetVolumeTypeExtraSpec(volumeType.ID, key, value))
+		})
+	case 'U':
+		showUnsetSpecKeyForm(fmt.Sprintf("Unset extra spec on %s", volumeType.Name), volumeType.ExtraSpecs, func(key string) {
+			refresh(fmt.Sprintf("Removal of %s from %s", key, volumeType.Name), volumetypes.DeleteVolumeTypeExtraSpec(volumeType.ID, key))
+		})
+	case 'A':
+		if volumeType.IsPublic {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "%s is public; make it private with (E)dit to restrict access", volumeType.Name)
+			return true
+		}
+		var projectNames, projectIDs []string
+		for _, project := range projects.FetchProjects() {
+			projectNames = append(projectNames, project.Name)
+			projectIDs = append(projectIDs, project.ID)
+		}
+		if len(projectIDs) == 0 {
+			return true
+		}
+		form := tview.NewForm()
+		form.AddDropDown("Project", projectNames, 0, nil)
+		form.AddButton("Add", func() {
+			projectIndex, projectName := form.GetFormItemByLabel("Project").(*tview.DropDown).GetCurrentOption()
+			closeModal("form")
+			refresh(fmt.Sprintf("Access of %s to %s", projectName, volumeType.Name), volumetypes.AddVolumeTypeAccess(volumeType.ID, projectIDs[projectIndex]))
+		})
+		form.AddButton("Cancel", func() {
+			closeModal("form")
+		})
+		showForm(fmt.Sprintf("Add project access to %s", volumeType.Name), form, 7)
+	case 'R':
+		details := volumetypes.FetchVolumeTypeDetails(volumeType.ID, volumeType.IsPublic)
+		if details == nil || len(details.ProjectIDs) == 0 {
+			return true
+		}
+		var projectNames []string
+		for _, projectID := range details.ProjectIDs {
+			projectNames = append(projectNames, projects.FetchProjectName(projectID))
+		}
+		form := tview.NewForm()
+		form.AddDropDown("Project", projectNames, 0, nil)
+		form.AddButton("Remove", func() {
+			projectIndex, projectName := form.GetFormItemByLabel("Project").(*tview.DropDown).GetCurrentOption()
+			closeModal("form")
+			refresh(fmt.Sprintf("Removal of %s access to %s", projectName, volumeType.Name), volumetypes.RemoveVolumeTypeAccess(volumeType.ID, details.ProjectIDs[projectIndex]))
+		})
+		form.AddButton("Cancel", func() {
+			closeModal("form")
+		})
+		showForm(fmt.Sprintf("Remove project access to %s", volumeType.Name), form, 7)
+	case 'Q':
+		specNames := []string{"(none)"}
+		selected := 0
+		for i, spec := range qosItems {
+			specNames = append(specNames, spec.Name)
+			if spec.ID == volumeType.QosSpecID {
+				selected = i + 1
+			}
+		}
+		form := tview.NewForm()
+		form.AddDropDown("QoS spec", specNames, selected, nil)
+		form.AddButton("Associate", func() {
+			specIndex, specName := form.GetFormItemByLabel("QoS spec").(*tview.DropDown).GetCurrentOption()
+			closeModal("form")
+			// A volume type has at most one QoS spec, so drop the current one first.
+			var err error
+			if volumeType.QosSpecID != "" {
+				err = qos.DisassociateQoSSpec(volumeType.QosSpecID, volumeType.ID)
+			}
+			if err == nil && specIndex > 0 {
+				err = qos.AssociateQoSSpec(qosItems[specIndex-1].ID, volumeType.ID)
+			}
+			refresh(fmt.Sprintf("Association of QoS spec %s with %s", specName, volumeType.Name), err)
+		})
+		form.AddButton("Cancel", func() {
+			closeModal("form")
+		})
+		showForm(fmt.Sprintf("QoS spec of %s", volumeType.Name), form, 7)
+	case 'D':
+		confirmAction(fmt.Sprintf("Delete volume type %s?", volumeType.Name), func() {
+			err := volumetypes.DeleteVolumeType(volumeType.ID)
+			populateVolumeTypesList()
+			reportAction(fmt.Sprintf("Deletion of volume type %s", volumeType.Name), err)
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+// handleQoSSpecKey runs the QoS spec action bound to key and reports whether
+// there was one.
+func handleQoSSpecKey(key rune, spec openstack_qos.QoS, index int) bool {
+	refresh := func(description string, err error) {
+		populateVolumeTypesList()
+		volumeTypesList.SetCurrentItem(index)
+		reportAction(description, err)
+	}
+
+	switch key {
+	case 'S':
+		showSpecKeyForm(fmt.Sprintf("Set key on QoS spec %s", spec.Name), func(key, value string) {
+			refresh(fmt.Sprintf("Update of %s on %s", key, spec.Name), qos.SetQoSSpecKey(spec.ID, key, value))
+		})
+	case 'U':
+		showUnsetSpecKeyForm(fmt.Sprintf("Unset key on QoS spec %s", spec.Name), spec.Specs, func(key string) {
+			refresh(fmt.Sprintf("Removal of %s from %s", key, spec.Name), qos.DeleteQoSSpecKey(spec.ID, key))
+		})
+	case 'D':
+		confirmAction(fmt.Sprintf("Delete QoS spec %s?", spec.Name), func() {
+			err := qos.DeleteQoSSpec(spec.ID)
+			populateVolumeTypesList()
+			reportAction(fmt.Sprintf("Deletion of QoS spec %s", spec.Name), err)
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+// showVolumeTypeForm creates a volume type, or edits volumeType when it is set.
+func showVolumeTypeForm(volumeType *openstack_volumetypes.VolumeType) {
+	title, button := "Create volume type", "Create"
+	var name, description string
+	public := true
+	if volumeType != nil {
+		title, button = fmt.Sprintf("Edit volume type %s", volumeType.Name), "Save"
+		name, description, public = volumeType.Name, volumeType.Description, volumeType.IsPublic
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Name", name, 40, nil, nil)
+	form.AddInputField("Description", description, 40, nil, nil)
+	form.AddCheckbox("Public", public, nil)
+	form.AddButton(button, func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		description := form.GetFormItemByLabel("Description").(*tview.InputField).GetText()
+		public := form.GetFormItemByLabel("Public").(*tview.Checkbox).IsChecked()
+		closeModal("form")
+		var err error
+		if volumeType == nil {
+			err = volumetypes.CreateVolumeType(name, description, public)
+		} else {
+			err = volumetypes.UpdateVolumeType(volumeType.ID, name, description, public)
+		}
+		populateVolumeTypesList()
+		reportAction(fmt.Sprintf("Saving volume type %s", name), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(title, form, 11)
+}
+
+func showCreateQoSSpecForm() {
+	consumers := []openstack_qos.QoSConsumer{openstack_qos.ConsumerBack, openstack_qos.ConsumerFront, openstack_qos.ConsumerBoth}
+	form := tview.NewForm()
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddDropDown("Consumer", []string{string(consumers[0]), string(consumers[1]), string(consumers[2])}, 0, nil)
+	form.AddInputField("Key", "", 40, nil, nil)
+	form.AddInputField("Value", "", 40, nil, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		consumerIndex, _ := form.GetFormItemByLabel("Consumer").(*tview.DropDown).GetCurrentOption()
+		key := form.GetFormItemByLabel("Key").(*tview.InputField).GetText()
+		value := form.GetFormItemByLabel("Value").(*tview.InputField).GetText()
+		closeModal("form")
+		specs := map[string]string{}
+		if key != "" {
+			specs[key] = value
+		}
+		err := qos.CreateQoSSpec(name, consumers[consumerIndex], specs)
+		populateVolumeTypesList()
+		reportAction(fmt.Sprintf("Creation of QoS spec %s", name), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Create QoS spec", form, 13)
+}
+
+// showSpecKeyForm asks for a key and value and passes them to onSave.
+func showSpecKeyForm(title string, onSave func(key, value string)) {
+	form := tview.NewForm()
+	form.AddInputField("Key", "", 40, nil, nil)
+	form.AddInputField("Value", "", 40, nil, nil)
+	form.AddButton("Save", func() {
+		key := form.GetFormItemByLabel("Key").(*tview.InputField).GetText()
+		value := form.GetFormItemByLabel("Value").(*tview.InputField).GetText()
+		closeModal("form")
+		onSave(key, value)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(title, form, 9)
+}
+
+// showUnsetSpecKeyForm lets one of the keys of specs be picked and passes it to onUnset.
+func showUnsetSpecKeyForm(title string, specs map[string]string, onUnset func(key string)) {
+	if len(specs) == 0 {
+		return
+	}
+	var keys []string
+	for key := range specs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	form := tview.NewForm()
+	form.AddDropDown("Key", keys, 0, nil)
+	form.AddButton("Unset", func() {
+		_, key := form.GetFormItemByLabel("Key").(*tview.DropDown).GetCurrentOption()
+		closeModal("form")
+		onUnset(key)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(title, form, 7)
+}
+
+func populateTransfersList() {
+	transfersList.Clear()
+	volumeNames := map[string]string{}
+	for _, volume := range volumes.FetchVolumes(volumeListOpts) {
+		volumeNames[volume.ID] = volumeName(volume)
+	}
+
+	transferItems = transfers.FetchTransfers()
+	for _, transfer := range transferItems {
+		volume := volumeNames[transfer.VolumeID]
+		if volume == "" {
+			volume = transfer.VolumeID
 		}
-		aggregatesList.AddItem(aggregate.Name, "", -1, func() {
+		transfersList.AddItem(transfer.Name, fmt.Sprintf("%s, %s", volume, transfer.CreatedAt.Format(time.DateTime)), -1, func() {
 			detailsView.Clear()
-			fmt.Fprintf(detailsView, "ID: %d\nName: %s\nMetadata: %s\nHosts: %s", aggregate.ID, aggregate.Name, aggregate.Metadata, hosts)
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nVolume: %s (%s)\nCreated At: %s\n\n(A)ccept a transfer, (D)elete",
+				transfer.ID, transfer.Name, volume, transfer.VolumeID, transfer.CreatedAt)
 		})
 	}
 }
 
-func populateFlavorsList() {
-	flavorsList.Clear()
-	for _, flavor := range flavors.FetchFlavors() {
-		flavorsList.AddItem(flavor.Name, "", -1, func() {
+func showCreateTransferForm(volume openstack_volumes.Volume) {
+	form := tview.NewForm()
+	form.AddInputField("Name", volumeName(volume), 40, nil, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		closeModal("form")
+		transfer, err := transfers.CreateTransfer(volume.ID, name)
+		if !reportAction(fmt.Sprintf("Transfer of volume %s", volumeName(volume)), err) {
+			return
+		}
+		// The auth key is only returned on creation, so it is shown in a modal
+		// rather than kept anywhere.
+		acceptShortcuts = false
+		modal := tview.NewModal().
+			SetText(fmt.Sprintf("Transfer ID: %s\nAuth key: %s\n\nGive both to the receiving project. The auth key cannot be shown again.", transfer.ID, transfer.AuthKey)).
+			AddButtons([]string{"Done"}).
+			SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+				closeModal("transfer")
+				// The volume is now awaiting-transfer.
+				current := volumesList.GetCurrentItem()
+				populateVolumesList()
+				volumesList.SetCurrentItem(current)
+			})
+		pages.AddPage("transfer", modal, false, true)
+		app.SetFocus(modal)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Transfer volume %s", volumeName(volume)), form, 7)
+}
+
+func showAcceptTransferForm() {
+	form := tview.NewForm()
+	form.AddInputField("Transfer ID", "", 40, nil, nil)
+	form.AddInputField("Auth key", "", 40, nil, nil)
+	form.AddButton("Accept", func() {
+		transferID := form.GetFormItemByLabel("Transfer ID").(*tview.InputField).GetText()
+		authKey := form.GetFormItemByLabel("Auth key").(*tview.InputField).GetText()
+		closeModal("form")
+		err := transfers.AcceptTransfer(transferID, authKey)
+		populateTransfersList()
+		reportAction(fmt.Sprintf("Acceptance of transfer %s into %s", transferID, os.Getenv("OS_PROJECT_NAME")), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Accept volume transfer", form, 9)
+}
+
+// populateBlockStorageList lists the cinder services followed by the backend
+// pools, fullest first, so backends running out of space stand out.
+func populateBlockStorageList() {
+	blockStorageList.Clear()
+	for _, service := range volumes.FetchVolumeServices() {
+		up := service.State == "up"
+		blockStorageList.AddItem(fmt.Sprintf("[%s]%s@%s[-]", stateColor(up), service.Binary, service.Host), fmt.Sprintf("%s, %s, %s", service.Zone, service.Status, service.State), -1, func() {
 			detailsView.Clear()
-			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nVCPU: %d\nRAM: %d\nDisk: %d", flavor.ID, flavor.Name, flavor.VCPUs, flavor.RAM, flavor.Disk)
+			fmt.Fprintf(detailsView, "Binary: %s\nHost: %s\nZone: %s\nStatus: %s\nState: %s\nFrozen: %t\nCluster: %s\nReplication Status: %s\nUpdated At: %s\nDisabled Reason: %s",
+				service.Binary, service.Host, service.Zone, service.Status, service.State, service.Frozen, service.Cluster, service.ReplicationStatus, service.UpdatedAt, service.DisabledReason)
 		})
 	}
-}
 
-func populateVolumesList() {
-	volumesList.Clear()
-	for _, volume := range volumes.FetchVolumes() {
-		volumesList.AddItem(volume.Name, "", -1, func() {
+	pools := volumes.FetchStoragePools()
+	usedFraction := func(capabilities openstack_schedulerstats.Capabilities) float64 {
+		if capabilities.TotalCapacityGB <= 0 || math.IsInf(capabilities.TotalCapacityGB, 1) {
+			return 0
+		}
+		return (capabilities.TotalCapacityGB - capabilities.FreeCapacityGB) / capabilities.TotalCapacityGB
+	}
+	sort.SliceStable(pools, func(i, j int) bool {
+		return usedFraction(pools[i].Capabilities) > usedFraction(pools[j].Capabilities)
+	})
+
+	for _, pool := range pools {
+		capabilities := pool.Capabilities
+		used := usedFraction(capabilities)
+		color := "green"
+		switch {
+		case used >= 0.9:
+			color = "red"
+		case used >= 0.75:
+			color = "yellow"
+		}
+
+		capacity := "unlimited"
+		provisionedRatio := "n/a"
+		if !math.IsInf(capabilities.TotalCapacityGB, 1) && capabilities.TotalCapacityGB > 0 {
+			capacity = fmt.Sprintf("%.0f/%.0f GB free (%.0f%% used)", capabilities.FreeCapacityGB, capabilities.TotalCapacityGB, used*100)
+			provisionedRatio = fmt.Sprintf("%.2f:1", capabilities.ProvisionedCapacityGB/capabilities.TotalCapacityGB)
+		}
+
+		blockStorageList.AddItem(fmt.Sprintf("Pool: [%s]%s[-]", color, pool.Name), capacity, -1, func() {
 			detailsView.Clear()
-			fmt.Fprintf(detailsView, "\n\tID: %s\n\tName: %s\n\tDescription: %s\n\tCreated at: %s\n\tSize: %d\n\tType: %s", volume.ID, volume.Name, volume.Description, volume.CreatedAt, volume.Size, volume.VolumeType)
+			fmt.Fprintf(detailsView, "Pool: %s\nBackend: %s\nVendor: %s\nDriver Version: %s\nStorage Protocol: %s\n\nCapacity: %s\nAllocated: %.0f GB\nProvisioned: %.0f GB\nProvisioned Ratio: %s (max over-subscription %s)\nReserved: %d%%\nThin Provisioning: %t\nThick Provisioning: %t\nVolumes: %d",
+				pool.Name, capabilities.VolumeBackendName, capabilities.VendorName, capabilities.DriverVersion, capabilities.StorageProtocol,
+				capacity, capabilities.AllocatedCapacityGB, capabilities.ProvisionedCapacityGB, provisionedRatio, capabilities.MaxOverSubscriptionRatio,
+				capabilities.ReservedPercentage, capabilities.ThinProvisioningSupport, capabilities.ThickProvisioningSupport, capabilities.TotalVolumes)
 		})
 	}
 }
 
+// watchVolumeProgress follows a volume being created, extended or retyped
+// until it settles as available or in-use, or fails.
+func watchVolumeProgress(volumeID string) {
+	watchProgress("Volume Progress", func() (string, bool) {
+		volume := volumes.FetchVolumeByID(volumeID)
+		if volume == nil {
+			return fmt.Sprintf("Unable to fetch volume %s", volumeID), true
+		}
+
+		text := fmt.Sprintf("Volume: %s (%s)\nStatus: %s\nSize: %d GB\nType: %s\nAvailability Zone: %s", volume.Name, volume.ID, volume.Status, volume.Size, volume.VolumeType, volume.AvailabilityZone)
+		switch volume.Status {
+		case "available", "in-use":
+			return text + "\n\n[green]Volume is " + volume.Status + ".[-]", true
+		case "error", "error_extending", "error_restoring", "error_deleting":
+			return text + "\n\n[red]Volume operation failed.[-]", true
+		}
+		return text, false
+	})
+}
+
 func populateHypervisorsList() {
+	hypervisorItems = hypervisors.FetchHypervisors()
+	renderHypervisorsList()
+
+	// Placement needs an inventory and a usage call per provider, so load it in
+	// the background and redraw with schedulable capacity once it arrives.
+	go func() {
+		capacities := placement.FetchResourceProviderCapacities()
+		app.QueueUpdateDraw(func() {
+			hypervisorCapacities = capacities
+			current := hypervisorsList.GetCurrentItem()
+			renderHypervisorsList()
+			hypervisorsList.SetCurrentItem(current)
+		})
+	}()
+}
+
+// renderHypervisorsList fills the hypervisors list and capacity summary. Used
+// and total figures come from placement when hypervisorCapacities has the
+// hypervisor, so they honour reserved amounts and the configured allocation
+// ratios rather than raw physical capacity.
+func renderHypervisorsList() {
 	hypervisorsList.Clear()
-	for _, hypervisor := range hypervisors.FetchHypervisors() {
-		hypervisorsList.AddItem(hypervisor.HypervisorHostname, "", -1, func() {
+	var vcpus, vcpusUsed, memoryMB, memoryMBUsed, localGB, localGBUsed, runningVMs int
+	for _, hypervisor := range hypervisorItems {
+		capacity := hypervisorCapacities[hypervisor.HypervisorHostname]
+		rowVCPUs, rowVCPUsUsed := hypervisor.VCPUs, hypervisor.VCPUsUsed
+		rowMemoryMB, rowMemoryMBUsed := hypervisor.MemoryMB, hypervisor.MemoryMBUsed
+		rowLocalGB, rowLocalGBUsed := hypervisor.LocalGB, hypervisor.LocalGBUsed
+		if capacity != nil {
+			rowVCPUs, rowVCPUsUsed = capacity.Capacity("VCPU"), capacity.Usages["VCPU"]
+			rowMemoryMB, rowMemoryMBUsed = capacity.Capacity("MEMORY_MB"), capacity.Usages["MEMORY_MB"]
+			rowLocalGB, rowLocalGBUsed = capacity.Capacity("DISK_GB"), capacity.Usages["DISK_GB"]
+		}
+		vcpus += rowVCPUs
+		vcpusUsed += rowVCPUsUsed
+		memoryMB += rowMemoryMB
+		memoryMBUsed += rowMemoryMBUsed
+		localGB += rowLocalGB
+		localGBUsed += rowLocalGBUsed
+		runningVMs += hypervisor.RunningVMs
+
+		columns := fmt.Sprintf("vCPU %d/%d  RAM %d/%dG  Disk %d/%dG  VMs %d", rowVCPUsUsed, rowVCPUs,
+			rowMemoryMBUsed/1024, rowMemoryMB/1024, rowLocalGBUsed, rowLocalGB, hypervisor.RunningVMs)
+		hypervisorsList.AddItem(hypervisor.HypervisorHostname, columns, -1, func() {
 			detailsView.Clear()
-			fmt.Fprintf(detailsView, "\n\tHostname: %s\n\tType: %s\n\tHost IP: %s\n\tState: %s\n\tCPU Info: %s", hypervisor.HypervisorHostname, hypervisor.HypervisorType, hypervisor.HostIP, hypervisor.State, hypervisor.CPUInfo)
+			cpuInfo := hypervisor.CPUInfo
+			fmt.Fprintf(detailsView, "\n\tHostname: %s\n\tType: %s\n\tHost IP: %s\n\tState: %s\n\tStatus: %s\n\tCPU Info: %s %s (%s), %d sockets, %d cores, %d threads",
+				hypervisor.HypervisorHostname, hypervisor.HypervisorType, hypervisor.HostIP, hypervisor.State, hypervisor.Status,
+				cpuInfo.Vendor, cpuInfo.Model, cpuInfo.Arch, cpuInfo.Topology.Sockets, cpuInfo.Topology.Cores, cpuInfo.Topology.Threads)
+			fmt.Fprintf(detailsView, "\n\n\tvCPUs: %d/%d used (%s of physical, %s)\n\tMemory: %d/%d MB used (%s of physical, %s, %d MB free)\n\tLocal Disk: %d/%d GB used (%s of physical, %s, %d GB available least)\n\tRunning VMs: %d\n\tCurrent Workload: %d",
+				hypervisor.VCPUsUsed, hypervisor.VCPUs, allocationRatio(hypervisor.VCPUsUsed, hypervisor.VCPUs), configuredAllocation(capacity, "VCPU"),
+				hypervisor.MemoryMBUsed, hypervisor.MemoryMB, allocationRatio(hypervisor.MemoryMBUsed, hypervisor.MemoryMB), configuredAllocation(capacity, "MEMORY_MB"), hypervisor.FreeRamMB,
+				hypervisor.LocalGBUsed, hypervisor.LocalGB, allocationRatio(hypervisor.LocalGBUsed, hypervisor.LocalGB), configuredAllocation(capacity, "DISK_GB"), hypervisor.DiskAvailableLeast,
+				hypervisor.RunningVMs, hypervisor.CurrentWorkload)
+			fmt.Fprintf(detailsView, "\n\n(S)ervers on this hypervisor, (M)aintenance: disable and drain, (E)nable compute service")
 		})
 	}
+
+	hypervisorSummaryView.Clear()
+	fmt.Fprintf(hypervisorSummaryView, "vCPU %s\nRAM  %s\nDisk %s  Running VMs: %d",
+		capacityBar(vcpusUsed, vcpus, "vCPUs"), capacityBar(memoryMBUsed/1024, memoryMB/1024, "GB"), capacityBar(localGBUsed, localGB, "GB"), runningVMs)
+}
+
+// configuredAllocation describes the allocation ratio placement has for a
+// resource class and the schedulable capacity it results in.
+func configuredAllocation(capacity *placement.ResourceProviderDetails, class string) string {
+	if capacity == nil {
+		return "allocation ratio unknown"
+	}
+	inventory, ok := capacity.Inventories[class]
+	if !ok {
+		return "allocation ratio unknown"
+	}
+	return fmt.Sprintf("allocation ratio %.2f, %d schedulable", inventory.AllocationRatio, capacity.Capacity(class))
+}
+
+// allocationRatio renders how far a resource is allocated relative to its
+// physical capacity, e.g. "2.50:1" for a 2.5x overcommitted resource.
+func allocationRatio(used, total int) string {
+	if total == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.2f:1", float64(used)/float64(total))
+}
+
+// capacityBar charts used against total capacity, colored by how full it is,
+// followed by the remaining headroom.
+func capacityBar(used, total int, unit string) string {
+	const width = 40
+	if total == 0 {
+		return fmt.Sprintf("%s n/a", strings.Repeat("░", width))
+	}
+
+	filled := used * width / total
+	if filled > width {
+		filled = width
+	}
+	color := "green"
+	switch percent := used * 100 / total; {
+	case percent >= 90:
+		color = "red"
+	case percent >= 75:
+		color = "yellow"
+	}
+
+	return fmt.Sprintf("[%s]%s[-]%s %d/%d %s used, %d %s headroom (%d%%)", color, strings.Repeat("█", filled), strings.Repeat("░", width-filled),
+		used, total, unit, total-used, unit, used*100/total)
+}
+
+// showHypervisorServers drills down from a hypervisor into the servers list,
+// filtered to the servers of every project running on its host.
+func showHypervisorServers(hypervisor openstack_hypervisors.Hypervisor) {
+	if hypervisorServersParentOpts == nil {
+		parentOpts := serverListOpts
+		hypervisorServersParentOpts = &parentOpts
+	}
+	serverListOpts.AllTenants = true
+	serverListOpts.Host = hypervisor.Service.Host
+	populateServersList()
+	pages.SwitchToPage("servers")
+	detailsView.Clear()
+}
+
+// leaveHypervisorServers restores the server filters in place before a hypervisor drill-down.
+func leaveHypervisorServers() {
+	if hypervisorServersParentOpts == nil {
+		return
+	}
+	serverListOpts = *hypervisorServersParentOpts
+	hypervisorServersParentOpts = nil
+}
+
+// showMaintenanceForm starts the host maintenance workflow for a hypervisor:
+// disable its compute service and move every server off it.
+func showMaintenanceForm(hypervisor openstack_hypervisors.Hypervisor) {
+	host := hypervisor.Service.Host
+	hostDown := hypervisor.State == "down"
+	concurrencyOptions := []string{"1", "2", "4", "8"}
+
+	form := tview.NewForm()
+	form.AddInputField("Disable reason", "maintenance", 40, nil, nil)
+	form.AddDropDown("Concurrent moves", concurrencyOptions, 1, nil)
+	form.AddCheckbox("Evacuate (host is down)", hostDown, nil)
+	form.AddCheckbox("Block migration", false, nil)
+	form.AddButton("Start", func() {
+		reason := form.GetFormItemByLabel("Disable reason").(*tview.InputField).GetText()
+		concurrencyIndex, _ := form.GetFormItemByLabel("Concurrent moves").(*tview.DropDown).GetCurrentOption()
+		evacuate := form.GetFormItemByLabel("Evacuate (host is down)").(*tview.Checkbox).IsChecked()
+		blockMigration := form.GetFormItemByLabel("Block migration").(*tview.Checkbox).IsChecked()
+		closeModal("form")
+
+		serviceList := services.FetchServices("nova-compute", host)
+		if len(serviceList) == 0 {
+			reportAction(fmt.Sprintf("Maintenance of %s", host), fmt.Errorf("no nova-compute service found on %s", host))
+			return
+		}
+		concurrency := 1 << concurrencyIndex
+		action := "live-migrate"
+		if evacuate {
+			action = "evacuate"
+		}
+		confirmAction(fmt.Sprintf("Disable nova-compute on %s and %s all of its servers, %d at a time?", host, action, concurrency), func() {
+			drain := maintenance.StartDrain(host, serviceList[0].ID, reason, evacuate, blockMigration, concurrency)
+			watchDrainProgress(drain)
+		})
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm(fmt.Sprintf("Maintenance of %s", host), form, 15)
+}
+
+// watchDrainProgress shows the per-server progress of a host maintenance drain.
+func watchDrainProgress(drain *maintenance.Drain) {
+	watchProgress(fmt.Sprintf("Maintenance: %s", drain.Host), func() (string, bool) {
+		progressList, finished, err := drain.Progress()
+		if err != nil {
+			return fmt.Sprintf("[red]Maintenance of %s stopped: %s[-]", drain.Host, err), true
+		}
+
+		var moved, failed int
+		var lines string
+		for _, progress := range progressList {
+			color := "white"
+			switch {
+			case progress.Err != nil:
+				failed++
+				color = "red"
+			case strings.HasPrefix(progress.Status, "done"):
+				moved++
+				color = "green"
+			case strings.HasPrefix(progress.Status, "running"):
+				color = "yellow"
+			}
+			lines += fmt.Sprintf("\n[%s]%s (%s): %s %s[-]", color, tview.Escape(progress.Name), progress.ID, progress.Action, progress.Status)
+			if progress.Err != nil {
+				lines += fmt.Sprintf("\n\t%s", tview.Escape(progress.Err.Error()))
+			}
+		}
+
+		serviceState := "disabling compute service"
+		if drain.ServiceDisabled() {
+			serviceState = "compute service disabled"
+		}
+		text := fmt.Sprintf("Host: %s (%s)\nServers: %d, moved: %d, failed: %d\n%s", drain.Host, serviceState, len(progressList), moved, failed, lines)
+		if finished {
+			text += "\n\nMaintenance workflow finished. Press E on the hypervisor to re-enable the compute service when done."
+		}
+		return text, finished
+	})
 }
 
 func populateLoadbalancersList() {
@@ -463,10 +3592,542 @@ func populateNetworksList() {
 
 func populateImagesList() {
 	imagesList.Clear()
-	for _, image := range images.FetchImages() {
+	imageItems = images.FetchImages()
+	for _, image := range imageItems {
 		imagesList.AddItem(image.Name, "", -1, func() {
 			detailsView.Clear()
-			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nSize: %d", image.ID, image.Name, image.SizeBytes)
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nStatus: %s\nSize: %d", image.ID, image.Name, image.Status, image.SizeBytes)
+			if image.Status != openstack_images.ImageStatusActive {
+				fmt.Fprintf(detailsView, "\n\n(P)rogress")
+			}
+		})
+	}
+}
+
+func populateKeypairsList() {
+	keypairsList.Clear()
+	for _, keypair := range keypairs.FetchKeypairs() {
+		keypairsList.AddItem(keypair.Name, "", -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Name: %s\nType: %s\nFingerprint: %s\nPublic Key: %s\n\n(N)ew import, (G)enerate, (D)elete", keypair.Name, keypair.Type, keypair.Fingerprint, keypair.PublicKey)
+		})
+	}
+}
+
+func populateServerGroupsList() {
+	serverGroupsList.Clear()
+	if serverGroupsAllProjects {
+		serverGroupsList.SetTitle(" Server Groups (all projects) ")
+	} else {
+		serverGroupsList.SetTitle(" Server Groups ")
+	}
+	// One server listing covers the members of every group.
+	memberServers := map[string]servers.ServerWithExt{}
+	hosts := map[string]string{}
+	for _, server := range servers.FetchServers(openstack_servers.ListOpts{AllTenants: serverGroupsAllProjects}) {
+		memberServers[server.ID] = server
+		hosts[server.ID] = server.Host
+	}
+
+	for _, serverGroup := range servergroups.FetchServerGroups(serverGroupsAllProjects) {
+		policy := servergroups.Policy(serverGroup)
+		var members string
+		for _, memberID := range serverGroup.Members {
+			member, ok := memberServers[memberID]
+			if !ok {
+				members += fmt.Sprintf("\n\t%s (unable to fetch)", memberID)
+				continue
+			}
+			members += fmt.Sprintf("\n\t%s (%s) on %s", member.Name, memberID, member.Host)
+		}
+		violations := servergroups.PolicyViolations(serverGroup, hosts)
+
+		secondary := policy
+		if len(violations) > 0 {
+			secondary = fmt.Sprintf("[red]%s: policy violated[-]", policy)
+		}
+		serverGroupsList.AddItem(serverGroup.Name, secondary, -1, func() {
+			detailsView.Clear()
+			var rules string
+			if serverGroup.Rules != nil && serverGroup.Rules.MaxServerPerHost > 0 {
+				rules = fmt.Sprintf("max %d servers per host", serverGroup.Rules.MaxServerPerHost)
+			}
+			fmt.Fprintf(detailsView, "ID: %s\nName: %s\nPolicy: %s\nRules: %s\nProject: %s\nMembers: %s", serverGroup.ID, serverGroup.Name, policy, rules, projects.FetchProjectName(serverGroup.ProjectID), members)
+			if len(violations) > 0 {
+				fmt.Fprintf(detailsView, "\n\nPolicy violations:")
+				for _, violation := range violations {
+					fmt.Fprintf(detailsView, "\n\t%s", violation)
+				}
+			}
+			fmt.Fprintf(detailsView, "\n\n(G)lobal all-projects toggle")
+		})
+	}
+}
+
+func populateUsageList() {
+	usageList.Clear()
+	start, end := usageWindow()
+	usageList.SetTitle(fmt.Sprintf(" Usage %s - %s ", start.Format("2006-01-02"), end.Add(-time.Second).Format("2006-01-02")))
+
+	tenantUsages := usage.FetchAllTenantsUsage(start, end)
+	if tenantUsages == nil {
+		// Non-admins can only see the usage of their own project.
+		domain := projects.FetchDomainIDByName(os.Getenv("OS_USER_DOMAIN_NAME"))
+		project := projects.FetchProjectByName(os.Getenv("OS_PROJECT_NAME"), domain.ID)
+		if tenantUsage := usage.FetchTenantUsage(project.ID, start, end); tenantUsage != nil {
+			tenantUsages = append(tenantUsages, *tenantUsage)
+		}
+	}
+
+	for _, tenantUsage := range tenantUsages {
+		projectName := projects.FetchProjectName(tenantUsage.TenantID)
+		usageList.AddItem(projectName, fmt.Sprintf("%.0f vCPU-h, %.0f GB RAM-h", tenantUsage.TotalVCPUsUsage, tenantUsage.TotalMemoryMBUsage/1024), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Project: %s (%s)\nWindow: %s - %s\nServer Hours: %.1f\nvCPU Hours: %.1f\nRAM Hours: %.1f GB-h\nDisk Hours: %.1f GB-h\n\nServers:",
+				projectName, tenantUsage.TenantID, tenantUsage.Start.Format("2006-01-02 15:04"), tenantUsage.Stop.Format("2006-01-02 15:04"),
+				tenantUsage.TotalHours, tenantUsage.TotalVCPUsUsage, tenantUsage.TotalMemoryMBUsage/1024, tenantUsage.TotalLocalGBUsage)
+			for _, serverUsage := range tenantUsage.ServerUsages {
+				fmt.Fprintf(detailsView, "\n\t%s (%s): %s, %.1f hours, %d vCPU, %d MB RAM, %d GB disk, %s",
+					serverUsage.Name, serverUsage.InstanceID, serverUsage.Flavor, serverUsage.Hours, serverUsage.VCPUs, serverUsage.MemoryMB, serverUsage.LocalGB, serverUsage.State)
+			}
+			fmt.Fprintf(detailsView, "\n\n(W)indow")
+		})
+	}
+}
+
+// usageWindow returns the time window of the usage view, defaulting to the
+// last 30 days.
+func usageWindow() (time.Time, time.Time) {
+	if usageEnd.IsZero() {
+		end := time.Now()
+		return end.AddDate(0, 0, -30), end
+	}
+	return usageStart, usageEnd
+}
+
+func showUsageWindowForm() {
+	start, end := usageWindow()
+	form := tview.NewForm()
+	form.AddInputField("Start (YYYY-MM-DD)", start.Format("2006-01-02"), 20, nil, nil)
+	form.AddInputField("End (YYYY-MM-DD)", end.Add(-time.Second).Format("2006-01-02"), 20, nil, nil)
+	form.AddButton("Apply", func() {
+		start, startErr := time.Parse("2006-01-02", form.GetFormItemByLabel("Start (YYYY-MM-DD)").(*tview.InputField).GetText())
+		end, endErr := time.Parse("2006-01-02", form.GetFormItemByLabel("End (YYYY-MM-DD)").(*tview.InputField).GetText())
+		closeModal("form")
+		if startErr != nil || endErr != nil || end.Before(start) {
+			reportAction("Usage window change", fmt.Errorf("invalid time window"))
+			return
+		}
+		// The end date is inclusive, so the window runs to the end of that day.
+		usageStart, usageEnd = start, end.AddDate(0, 0, 1)
+		populateUsageList()
+		detailsView.Clear()
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Usage time window", form, 9)
+}
+
+func populateServicesList() {
+	servicesList.Clear()
+	serviceItems = services.FetchServices("", "")
+	for _, service := range serviceItems {
+		color := "green"
+		if service.State != "up" {
+			color = "red"
+		}
+		secondary := fmt.Sprintf("%s, %s", service.Zone, service.Status)
+		if service.ForcedDown {
+			secondary += ", forced down"
+		}
+		servicesList.AddItem(fmt.Sprintf("[%s]%s@%s[-]", color, service.Binary, service.Host), secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %s\nBinary: %s\nHost: %s\nZone: %s\nStatus: %s\nState: %s\nForced Down: %t\nUpdated At: %s\nDisabled Reason: %s\n\n(E)nable, (D)isable, (F)orced down toggle",
+				service.ID, service.Binary, service.Host, service.Zone, service.Status, service.State, service.ForcedDown, service.UpdatedAt, service.DisabledReason)
+		})
+	}
+}
+
+// populateZonesList lists each compute and volume availability zone followed
+// by its hosts, with the state of the services running on them.
+func populateZonesList() {
+	zonesList.Clear()
+	zoneItems = nil
+
+	zoneAggregates := map[string][]string{}
+	for _, aggregate := range aggregates.FetchAggregates() {
+		if aggregate.AvailabilityZone != "" {
+			zoneAggregates[aggregate.AvailabilityZone] = append(zoneAggregates[aggregate.AvailabilityZone], aggregate.Name)
+		}
+	}
+
+	for _, zone := range availabilityzones.FetchComputeAvailabilityZones() {
+		var hostNames []string
+		for host := range zone.Hosts {
+			hostNames = append(hostNames, host)
+		}
+		sort.Strings(hostNames)
+
+		definedBy := strings.Join(zoneAggregates[zone.ZoneName], ", ")
+		zoneItems = append(zoneItems, zoneEntry{compute: true, zone: zone.ZoneName})
+		zonesList.AddItem(fmt.Sprintf("[%s]%s[-]", stateColor(zone.ZoneState.Available), zone.ZoneName), fmt.Sprintf("compute, %d hosts", len(hostNames)), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Zone: %s\nType: compute\nAvailable: %t\nAggregates: %s\nHosts:", zone.ZoneName, zone.ZoneState.Available, definedBy)
+			for _, host := range hostNames {
+				fmt.Fprintf(detailsView, "\n\t%s", host)
+			}
+			fmt.Fprintf(detailsView, "\n\n(A)ggregates defining this zone")
+		})
+
+		for _, host := range hostNames {
+			var binaries []string
+			for binary := range zone.Hosts[host] {
+				binaries = append(binaries, binary)
+			}
+			sort.Strings(binaries)
+
+			up := true
+			var states []string
+			for _, binary := range binaries {
+				state := zone.Hosts[host][binary]
+				up = up && state.Available && state.Active
+				states = append(states, fmt.Sprintf("%s %s", binary, serviceStateText(state.Available, state.Active)))
+			}
+
+			zoneItems = append(zoneItems, zoneEntry{compute: true, zone: zone.ZoneName, host: host})
+			zonesList.AddItem(fmt.Sprintf("  [%s]%s[-]", stateColor(up), host), "  "+strings.Join(states, ", "), -1, func() {
+				detailsView.Clear()
+				fmt.Fprintf(detailsView, "Host: %s\nZone: %s\nAggregates: %s\nServices:", host, zone.ZoneName, definedBy)
+				for _, binary := range binaries {
+					state := zone.Hosts[host][binary]
+					fmt.Fprintf(detailsView, "\n\t%s: %s, updated %s", binary, serviceStateText(state.Available, state.Active), state.UpdatedAt)
+				}
+				fmt.Fprintf(detailsView, "\n\n(H)ypervisor of this host, (A)ggregates defining this zone")
+			})
+		}
+	}
+
+	// Cinder does not report hosts per zone, so group its services by zone instead.
+	volumeServices := map[string][]openstack_volume_services.Service{}
+	for _, service := range volumes.FetchVolumeServices() {
+		volumeServices[service.Zone] = append(volumeServices[service.Zone], service)
+	}
+	for _, zone := range availabilityzones.FetchVolumeAvailabilityZones() {
+		zoneServices := volumeServices[zone.ZoneName]
+		zoneItems = append(zoneItems, zoneEntry{zone: zone.ZoneName})
+		zonesList.AddItem(fmt.Sprintf("[%s]%s[-]", stateColor(zone.ZoneState.Available), zone.ZoneName), fmt.Sprintf("volume, %d services", len(zoneServices)), -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "Zone: %s\nType: volume\nAvailable: %t\nServices:", zone.ZoneName, zone.ZoneState.Available)
+			for _, service := range zoneServices {
+				fmt.Fprintf(detailsView, "\n\t%s on %s: %s, %s", service.Binary, service.Host, service.State, service.Status)
+			}
+		})
+
+		for _, service := range zoneServices {
+			zoneItems = append(zoneItems, zoneEntry{zone: zone.ZoneName, host: service.Host})
+			zonesList.AddItem(fmt.Sprintf("  [%s]%s[-]", stateColor(service.State == "up" && service.Status == "enabled"), service.Host),
+				fmt.Sprintf("  %s %s, %s", service.Binary, service.State, service.Status), -1, func() {
+					detailsView.Clear()
+					fmt.Fprintf(detailsView, "Host: %s\nZone: %s\nBinary: %s\nState: %s\nStatus: %s\nUpdated At: %s\nDisabled Reason: %s",
+						service.Host, zone.ZoneName, service.Binary, service.State, service.Status, service.UpdatedAt, service.DisabledReason)
+				})
+		}
+	}
+}
+
+// stateColor is the list color for something that is up (green) or not (red).
+func stateColor(up bool) string {
+	if up {
+		return "green"
+	}
+	return "red"
+}
+
+func serviceStateText(available, active bool) string {
+	state := "down"
+	if available {
+		state = "up"
+	}
+	if !active {
+		return state + ", disabled"
+	}
+	return state + ", enabled"
+}
+
+func populateResourceProvidersList() {
+	resourceProvidersList.Clear()
+	resourceProviderItems = placement.FetchResourceProviders()
+	names := map[string]string{}
+	for _, provider := range resourceProviderItems {
+		names[provider.UUID] = provider.Name
+	}
+	for _, provider := range resourceProviderItems {
+		secondary := provider.UUID
+		if provider.ParentProviderUUID != "" {
+			secondary = fmt.Sprintf("child of %s", names[provider.ParentProviderUUID])
+		}
+		resourceProvidersList.AddItem(provider.Name, secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "UUID: %s\nName: %s\nGeneration: %d\nParent: %s\nRoot: %s",
+				provider.UUID, provider.Name, provider.Generation, names[provider.ParentProviderUUID], names[provider.RootProviderUUID])
+
+			details := placement.FetchResourceProviderDetails(provider.UUID)
+			if details == nil {
+				fmt.Fprintf(detailsView, "\n\nFailed to load inventories, usages and traits")
+				return
+			}
+
+			var classes []string
+			for class := range details.Inventories {
+				classes = append(classes, class)
+			}
+			sort.Strings(classes)
+			fmt.Fprintf(detailsView, "\n\nInventories:")
+			for _, class := range classes {
+				inventory := details.Inventories[class]
+				// This is the same capacity the scheduler checks allocation candidates against.
+				capacity := details.Capacity(class)
+				used := details.Usages[class]
+				fmt.Fprintf(detailsView, "\n\t%s: %d/%d used, %d free (total %d, reserved %d, allocation ratio %.2f, unit %d-%d step %d)",
+					class, used, capacity, capacity-used, inventory.Total, inventory.Reserved, inventory.AllocationRatio,
+					inventory.MinUnit, inventory.MaxUnit, inventory.StepSize)
+			}
+
+			fmt.Fprintf(detailsView, "\n\nTraits:")
+			sort.Strings(details.Traits)
+			for _, trait := range details.Traits {
+				fmt.Fprintf(detailsView, "\n\t%s", trait)
+			}
+
+			fmt.Fprintf(detailsView, "\n\nAggregates:")
+			for _, aggregate := range details.Aggregates {
+				fmt.Fprintf(detailsView, "\n\t%s", aggregate)
+			}
+			fmt.Fprintf(detailsView, "\n\n(F)ind allocations of a server")
+		})
+	}
+}
+
+// showAllocationsLookupForm shows the placement allocations held by a server,
+// to diagnose "No valid host" errors and leaked allocations.
+func showAllocationsLookupForm() {
+	serverID := ""
+	if server := selectedServer(); server != nil {
+		serverID = server.ID
+	}
+	form := tview.NewForm()
+	form.AddInputField("Server ID", serverID, 40, nil, nil)
+	form.AddButton("Find", func() {
+		consumerID := form.GetFormItemByLabel("Server ID").(*tview.InputField).GetText()
+		closeModal("form")
+		detailsView.Clear()
+		allocations, err := placement.FetchConsumerAllocations(consumerID)
+		if err != nil {
+			fmt.Fprintf(detailsView, "Allocations lookup for %s failed:\n\t%s", consumerID, err)
+			return
+		}
+
+		name := consumerID
+		if server := servers.FetchServer(consumerID); server != nil {
+			name = fmt.Sprintf("%s (%s)", server.Name, consumerID)
+		}
+		fmt.Fprintf(detailsView, "Allocations of %s\nProject: %s\nUser: %s", name, allocations.ProjectID, allocations.UserID)
+		if len(allocations.Allocations) == 0 {
+			fmt.Fprintf(detailsView, "\n\nNo allocations found")
+			return
+		}
+
+		providerNames := map[string]string{}
+		for _, provider := range resourceProviderItems {
+			providerNames[provider.UUID] = provider.Name
+		}
+		var providerIDs []string
+		for providerID := range allocations.Allocations {
+			providerIDs = append(providerIDs, providerID)
+		}
+		sort.Strings(providerIDs)
+		for _, providerID := range providerIDs {
+			allocation := allocations.Allocations[providerID]
+			providerName := providerNames[providerID]
+			if providerName == "" {
+				providerName = providerID
+			}
+			fmt.Fprintf(detailsView, "\n\nProvider: %s", providerName)
+			var classes []string
+			for class := range allocation.Resources {
+				classes = append(classes, class)
+			}
+			sort.Strings(classes)
+			for _, class := range classes {
+				fmt.Fprintf(detailsView, "\n\t%s: %d", class, allocation.Resources[class])
+			}
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Find server allocations", form, 7)
+}
+
+func populateMigrationsList() {
+	migrationItems = migrations.FetchMigrations(migrationsHost, migrationsStatus, time.Now().AddDate(0, 0, -migrationsDays), "", migrationsPageSize)
+
+	// One listing resolves every name; deleted servers are not listed, so
+	// their migrations keep the instance UUID.
+	migrationServerNames = map[string]string{}
+	for _, server := range servers.FetchServers(openstack_servers.ListOpts{AllTenants: true}) {
+		migrationServerNames[server.ID] = server.Name
+	}
+
+	renderMigrationsList()
+}
+
+// loadMoreMigrations fetches the page of migrations following the ones shown.
+func loadMoreMigrations() {
+	if len(migrationItems) == 0 {
+		return
+	}
+	marker := migrationItems[len(migrationItems)-1].UUID
+	next := migrations.FetchMigrations(migrationsHost, migrationsStatus, time.Now().AddDate(0, 0, -migrationsDays), marker, migrationsPageSize)
+	if len(next) == 0 {
+		detailsView.Clear()
+		fmt.Fprintf(detailsView, "No more migrations in the last %d days", migrationsDays)
+		return
+	}
+	current := migrationsList.GetCurrentItem()
+	migrationItems = append(migrationItems, next...)
+	renderMigrationsList()
+	migrationsList.SetCurrentItem(current)
+}
+
+func renderMigrationsList() {
+	migrationsList.Clear()
+	title := fmt.Sprintf(" Migrations (last %d days, %d shown) ", migrationsDays, len(migrationItems))
+	if migrationsHost != "" || migrationsStatus != "" {
+		title = fmt.Sprintf(" Migrations (last %d days, %d shown, host %q, status %q) ", migrationsDays, len(migrationItems), migrationsHost, migrationsStatus)
+	}
+	migrationsList.SetTitle(title)
+
+	for _, migration := range migrationItems {
+		name := migrationServerNames[migration.InstanceUUID]
+		if name == "" {
+			name = migration.InstanceUUID
+		}
+		color := "yellow"
+		switch migration.Status {
+		case "completed", "confirmed", "finished", "done":
+			color = "green"
+		case "error", "failed", "cancelled", "reverted":
+			color = "red"
+		}
+		secondary := fmt.Sprintf("%-14s %s -> %s  %s", migration.MigrationType, migration.SourceCompute, migration.DestCompute, migration.CreatedAt)
+		migrationsList.AddItem(fmt.Sprintf("%s [%s]%s[-]", name, color, migration.Status), secondary, -1, func() {
+			detailsView.Clear()
+			fmt.Fprintf(detailsView, "ID: %d\nUUID: %s\nInstance: %s (%s)\nType: %s\nStatus: %s\nSource: %s (%s)\nDestination: %s (%s, %s)\nOld Flavor ID: %d\nNew Flavor ID: %d\nCreated At: %s\nUpdated At: %s\n\n(F)ilter by host, status and age, load (M)ore",
+				migration.ID, migration.UUID, name, migration.InstanceUUID, migration.MigrationType, migration.Status,
+				migration.SourceCompute, migration.SourceNode, migration.DestCompute, migration.DestNode, migration.DestHost,
+				migration.OldFlavorID, migration.NewFlavorID, migration.CreatedAt, migration.UpdatedAt)
 		})
 	}
 }
+
+func showMigrationsFilterForm() {
+	statuses := []string{"any", "queued", "preparing", "running", "post-migrating", "migrating", "finished", "confirmed", "completed", "reverted", "error", "failed", "cancelled"}
+	statusIndex := 0
+	for i, status := range statuses {
+		if status == migrationsStatus {
+			statusIndex = i
+		}
+	}
+	form := tview.NewForm()
+	form.AddInputField("Host", migrationsHost, 40, nil, nil)
+	form.AddDropDown("Status", statuses, statusIndex, nil)
+	form.AddInputField("Changed in the last days", strconv.Itoa(migrationsDays), 10, tview.InputFieldInteger, nil)
+	form.AddButton("Filter", func() {
+		days, err := strconv.Atoi(form.GetFormItemByLabel("Changed in the last days").(*tview.InputField).GetText())
+		if err != nil || days < 1 {
+			closeModal("form")
+			reportAction("Migrations filter", fmt.Errorf("days must be a positive number"))
+			return
+		}
+		migrationsDays = days
+		migrationsHost = form.GetFormItemByLabel("Host").(*tview.InputField).GetText()
+		_, migrationsStatus = form.GetFormItemByLabel("Status").(*tview.DropDown).GetCurrentOption()
+		if migrationsStatus == "any" {
+			migrationsStatus = ""
+		}
+		closeModal("form")
+		populateMigrationsList()
+		detailsView.Clear()
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Filter migrations", form, 11)
+}
+
+func showImportKeypairForm() {
+	home, _ := os.UserHomeDir()
+	form := tview.NewForm()
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddInputField("Public key file", filepath.Join(home, ".ssh", "id_ed25519.pub"), 40, nil, nil)
+	form.AddButton("Import", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		path := form.GetFormItemByLabel("Public key file").(*tview.InputField).GetText()
+		closeModal("form")
+		err := keypairs.ImportKeypairFromFile(name, path)
+		populateKeypairsList()
+		reportAction(fmt.Sprintf("Import of keypair %s from %s", name, path), err)
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Import keypair", form, 11)
+}
+
+func showGenerateKeypairForm() {
+	home, _ := os.UserHomeDir()
+	keyTypes := []string{"ed25519", "rsa"}
+	form := tview.NewForm()
+	form.AddInputField("Name", "", 40, nil, nil)
+	form.AddDropDown("Type", keyTypes, 0, nil)
+	form.AddInputField("Private key file", filepath.Join(home, ".ssh", "openstack_key"), 40, nil, nil)
+	form.AddButton("Generate", func() {
+		name := form.GetFormItemByLabel("Name").(*tview.InputField).GetText()
+		typeIndex, _ := form.GetFormItemByLabel("Type").(*tview.DropDown).GetCurrentOption()
+		path := form.GetFormItemByLabel("Private key file").(*tview.InputField).GetText()
+		closeModal("form")
+		err := keypairs.GenerateKeypair(name, keyTypes[typeIndex], path)
+		populateKeypairsList()
+		if reportAction(fmt.Sprintf("Generation of %s keypair %s", keyTypes[typeIndex], name), err) {
+			fmt.Fprintf(detailsView, "\nPrivate key saved to %s", path)
+		}
+	})
+	form.AddButton("Cancel", func() {
+		closeModal("form")
+	})
+	showForm("Generate keypair", form, 13)
+}
+
+// watchImageProgress follows an image upload (e.g. a server snapshot) until it
+// becomes active or fails, then refreshes the images list.
+func watchImageProgress(imageID string) {
+	watchProgress("Image Progress", func() (string, bool) {
+		image := images.FetchImageByID(imageID)
+		if image == nil {
+			return fmt.Sprintf("Unable to fetch image %s", imageID), true
+		}
+
+		text := fmt.Sprintf("Image: %s (%s)\nStatus: %s\nUploaded: %d MB", image.Name, image.ID, image.Status, image.SizeBytes/1024/1024)
+		switch image.Status {
+		case openstack_images.ImageStatusActive:
+			app.QueueUpdateDraw(populateImagesList)
+			return text + "\n\n[green]Image is active.[-]", true
+		case openstack_images.ImageStatusKilled, openstack_images.ImageStatusDeleted:
+			app.QueueUpdateDraw(populateImagesList)
+			return text + "\n\n[red]Image upload failed.[-]", true
+		}
+		return text, false
+	})
+}